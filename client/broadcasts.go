@@ -0,0 +1,182 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Broadcast markets in TVBroadcast.Market
+const (
+	MarketNational = "N"
+	MarketHome     = "H" // the home team's regional network
+	MarketAway     = "A" // the away team's regional network
+)
+
+// ParseMarket parses a broadcast market: national, home or away, or the
+// API's N, H or A
+func ParseMarket(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "n", "national":
+		return MarketNational, nil
+	case "h", "home":
+		return MarketHome, nil
+	case "a", "away":
+		return MarketAway, nil
+	}
+	return "", fmt.Errorf("invalid market %q, want national, home or away", s)
+}
+
+// BroadcastFilter picks TV broadcasts, such as national US ones. Empty
+// fields match every broadcast.
+type BroadcastFilter struct {
+	Countries []string // country codes such as US or CA
+	Networks  []string // networks such as ESPN or SN
+	Markets   []string // MarketNational, MarketHome or MarketAway
+}
+
+// IsZero reports whether the filter matches every broadcast
+func (f *BroadcastFilter) IsZero() bool {
+	return f == nil || (len(f.Countries) == 0 && len(f.Networks) == 0 && len(f.Markets) == 0)
+}
+
+// Matches reports whether broadcast passes the filter. A nil filter
+// matches every broadcast.
+func (f *BroadcastFilter) Matches(broadcast TVBroadcast) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Countries) > 0 && !containsFold(f.Countries, broadcast.CountryCode) {
+		return false
+	}
+	if len(f.Networks) > 0 && !containsFold(f.Networks, broadcast.Network) {
+		return false
+	}
+	if len(f.Markets) > 0 && !containsFold(f.Markets, broadcast.Market) {
+		return false
+	}
+	return true
+}
+
+// Broadcasts returns the broadcasts passing the filter
+func (f *BroadcastFilter) Broadcasts(broadcasts []TVBroadcast) []TVBroadcast {
+	kept := []TVBroadcast{}
+	for _, b := range broadcasts {
+		if f.Matches(b) {
+			kept = append(kept, b)
+		}
+	}
+	return kept
+}
+
+// Games returns the games carried by a broadcast passing the filter, each
+// with only those broadcasts. A zero filter returns games unchanged.
+func (f *BroadcastFilter) Games(games []Game) []Game {
+	if f.IsZero() {
+		return games
+	}
+	kept := []Game{}
+	for _, game := range games {
+		if broadcasts := f.Broadcasts(game.TVBroadcasts); len(broadcasts) > 0 {
+			game.TVBroadcasts = broadcasts
+			kept = append(kept, game)
+		}
+	}
+	return kept
+}
+
+// TeamBroadcasts is the networks carrying each game of a team's season
+type TeamBroadcasts struct {
+	Team   string         `json:"team"`
+	Season int            `json:"season"`
+	Games  []ScheduleGame `json:"games"`
+}
+
+// GetTeamBroadcasts returns a team's season schedule with the networks
+// carrying each game. filter, which may be nil, picks the broadcasts, and
+// when it is not zero games without a matching broadcast are left out.
+func (c *Client) GetTeamBroadcasts(ctx context.Context, team *TeamInfo, seasonID int, filter *BroadcastFilter) (*TeamBroadcasts, error) {
+	schedule, err := c.GetTeamSchedule(ctx, team, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamBroadcasts{Team: team.Abbreviation, Season: seasonID, Games: []ScheduleGame{}}
+	for _, game := range schedule.Games {
+		broadcasts := filter.Broadcasts(game.TVBroadcasts)
+		if len(broadcasts) == 0 && !filter.IsZero() {
+			continue
+		}
+		game.TVBroadcasts = broadcasts
+		result.Games = append(result.Games, game)
+	}
+	return result, nil
+}
+
+// StreamingService is a service for watching NHL games in a country
+type StreamingService struct {
+	ID          int    `json:"id"`
+	CountryCode string `json:"countryCode"`
+	CountryName string `json:"countryName"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	LogoURL     string `json:"logoUrl"`
+}
+
+// GetWhereToWatch returns the services for watching NHL games. country,
+// such as US or CA, limits them to one country, and "" returns them all.
+func (c *Client) GetWhereToWatch(ctx context.Context, country string) ([]StreamingService, error) {
+	url := fmt.Sprintf("%s/where-to-watch", c.baseURL)
+	var response []StreamingService
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get where to watch: %w", err)
+	}
+
+	services := []StreamingService{}
+	for _, s := range response {
+		if country == "" || strings.EqualFold(s.CountryCode, country) {
+			services = append(services, s)
+		}
+	}
+	return services, nil
+}
+
+// TVSchedule is the NHL Network's programming around a date
+type TVSchedule struct {
+	Date       string             `json:"date"`
+	StartDate  string             `json:"startDate"`
+	EndDate    string             `json:"endDate"`
+	Broadcasts []NetworkBroadcast `json:"broadcasts"`
+}
+
+// NetworkBroadcast is a program on the NHL Network
+type NetworkBroadcast struct {
+	StartTime         string `json:"startTime"`
+	EndTime           string `json:"endTime"`
+	DurationSeconds   int    `json:"durationSeconds"`
+	Title             string `json:"title"`
+	Description       string `json:"description"`
+	HouseNumber       string `json:"houseNumber"`
+	BroadcastType     string `json:"broadcastType"` // such as Live or Taped
+	BroadcastStatus   string `json:"broadcastStatus"`
+	BroadcastImageURL string `json:"broadcastImageUrl"`
+}
+
+// GetTVSchedule returns the NHL Network's schedule for date, in
+// YYYY-MM-DD format, or for now when date is ""
+func (c *Client) GetTVSchedule(ctx context.Context, date string) (*TVSchedule, error) {
+	if date == "" {
+		date = "now"
+	}
+
+	url := fmt.Sprintf("%s/network/tv-schedule/%s", c.baseURL, date)
+	var response TVSchedule
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get TV schedule: %w", err)
+	}
+	if response.Broadcasts == nil {
+		response.Broadcasts = []NetworkBroadcast{}
+	}
+	return &response, nil
+}
@@ -0,0 +1,116 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestBroadcastFilter(t *testing.T) {
+	games := []Game{
+		{ID: 1, TVBroadcasts: []TVBroadcast{
+			{Network: "ESPN", CountryCode: "US", Market: MarketNational},
+			{Network: "SN", CountryCode: "CA", Market: MarketNational},
+		}},
+		{ID: 2, TVBroadcasts: []TVBroadcast{
+			{Network: "MSG", CountryCode: "US", Market: MarketHome},
+			{Network: "NBCSCH", CountryCode: "US", Market: MarketAway},
+		}},
+		{ID: 3},
+	}
+
+	tests := []struct {
+		name     string
+		filter   *BroadcastFilter
+		want     []int
+		networks int // broadcasts kept across the games
+	}{
+		{name: "Nil", filter: nil, want: []int{1, 2, 3}, networks: 4},
+		{name: "National US", filter: &BroadcastFilter{Countries: []string{"us"}, Markets: []string{MarketNational}}, want: []int{1}, networks: 1},
+		{name: "Network", filter: &BroadcastFilter{Networks: []string{"msg", "SN"}}, want: []int{1, 2}, networks: 2},
+		{name: "Regional", filter: &BroadcastFilter{Markets: []string{MarketHome, MarketAway}}, want: []int{2}, networks: 2},
+		{name: "No match", filter: &BroadcastFilter{Countries: []string{"SE"}}, want: nil, networks: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []int
+			networks := 0
+			for _, game := range tt.filter.Games(games) {
+				ids = append(ids, int(game.ID))
+				networks += len(game.TVBroadcasts)
+			}
+			if !equalInts(ids, tt.want) || networks != tt.networks {
+				t.Errorf("Games() = %v with %d broadcasts, want %v with %d", ids, networks, tt.want, tt.networks)
+			}
+		})
+	}
+	if len(games[0].TVBroadcasts) != 2 {
+		t.Error("Games() changed the games passed in")
+	}
+}
+
+func TestParseMarket(t *testing.T) {
+	for input, want := range map[string]string{"national": MarketNational, "H": MarketHome, " Away ": MarketAway} {
+		if got, err := ParseMarket(input); err != nil || got != want {
+			t.Errorf("ParseMarket(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseMarket("regional"); err == nil {
+		t.Error("ParseMarket(regional) should fail")
+	}
+}
+
+func TestGetTVSchedule(t *testing.T) {
+	var path string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			path = req.URL.Path
+			return mockResponse(http.StatusOK, map[string]any{
+				"date": "2024-02-09",
+				"broadcasts": []map[string]any{
+					{"startTime": "2024-02-09T19:00:00", "durationSeconds": 1800, "title": "NHL Tonight", "broadcastType": "Live"},
+				},
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	schedule, err := client.GetTVSchedule(context.Background(), "")
+	if err != nil {
+		t.Fatalf("GetTVSchedule() error = %v", err)
+	}
+	if path != "/v1/network/tv-schedule/now" {
+		t.Errorf("GetTVSchedule() requested %s", path)
+	}
+	if len(schedule.Broadcasts) != 1 || schedule.Broadcasts[0].Title != "NHL Tonight" || schedule.Broadcasts[0].DurationSeconds != 1800 {
+		t.Errorf("Broadcasts = %+v", schedule.Broadcasts)
+	}
+
+	if _, err := client.GetTVSchedule(context.Background(), "2024-02-09"); err != nil || path != "/v1/network/tv-schedule/2024-02-09" {
+		t.Errorf("GetTVSchedule(2024-02-09) requested %s, error = %v", path, err)
+	}
+}
+
+func TestGetWhereToWatch(t *testing.T) {
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/v1/where-to-watch" {
+				t.Errorf("GetWhereToWatch() requested %s", req.URL.Path)
+			}
+			return mockResponse(http.StatusOK, []map[string]any{
+				{"id": 1, "countryCode": "US", "title": "ESPN+"},
+				{"id": 2, "countryCode": "CA", "title": "Sportsnet+"},
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	all, err := client.GetWhereToWatch(context.Background(), "")
+	if err != nil || len(all) != 2 {
+		t.Fatalf("GetWhereToWatch() = %v, %v, want two services", all, err)
+	}
+	canada, err := client.GetWhereToWatch(context.Background(), "ca")
+	if err != nil || len(canada) != 1 || canada[0].Title != "Sportsnet+" {
+		t.Errorf("GetWhereToWatch(ca) = %v, %v, want Sportsnet+", canada, err)
+	}
+}
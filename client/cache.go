@@ -0,0 +1,362 @@
+package nhl
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NoExpiry is a TTL for responses that never change, such as a finished game's boxscore
+const NoExpiry time.Duration = -1
+
+// Cache stores raw API responses keyed by request URL.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the cached value for key, if present and not expired
+	Get(key string) ([]byte, bool)
+	// Set stores value for ttl, or forever if ttl is NoExpiry
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes key from the cache
+	Delete(key string)
+	// Purge removes every entry from the cache
+	Purge()
+}
+
+// CachePolicy returns how long the response body fetched from url may be
+// cached. A zero duration means the response is not cached.
+type CachePolicy func(url string, body []byte) time.Duration
+
+// WithCache enables response caching using cache
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithCachePolicy sets the policy deciding how long responses are cached
+func WithCachePolicy(policy CachePolicy) Option {
+	return func(c *Client) {
+		c.cachePolicy = policy
+	}
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context whose requests skip cached responses.
+// Fresh responses are still stored in the cache.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// cacheBypassed reports whether ctx was created by BypassCache
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// PurgeCache removes every cached response, including the team directory
+// and the player index
+func (c *Client) PurgeCache() {
+	if c.cache != nil {
+		c.cache.Purge()
+	}
+	c.cacheMutex.Lock()
+	c.teams = nil
+	c.teamsLoad = nil
+	c.players = nil
+	c.cacheMutex.Unlock()
+}
+
+// TTLs used by DefaultCachePolicy
+const (
+	LiveTTL      = 10 * time.Second
+	ScheduledTTL = time.Minute
+	StandingsTTL = 5 * time.Minute
+	StatsTTL     = time.Hour
+	RosterTTL    = 6 * time.Hour
+)
+
+var (
+	gamecenterPath    = regexp.MustCompile(`/(gamecenter/\d+/[\w-]+|wsc/game-story/\d+)$`)
+	scorePath         = regexp.MustCompile(`/score/\d{4}-\d{2}-\d{2}$`)
+	standingsDatePath = regexp.MustCompile(`/standings/\d{4}-\d{2}-\d{2}$`)
+)
+
+// DefaultCachePolicy caches responses based on the endpoint and, for game
+// data, the game state: final games never expire, live games expire within
+// seconds, and slowly changing data such as rosters is kept for hours.
+func DefaultCachePolicy(url string, body []byte) time.Duration {
+	path := url
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	switch {
+	case gamecenterPath.MatchString(path):
+		var game struct {
+			GameState GameState `json:"gameState"`
+		}
+		if err := json.Unmarshal(body, &game); err != nil {
+			return 0
+		}
+		return gameStateTTL(game.GameState)
+	case scorePath.MatchString(path):
+		var scores struct {
+			Games []struct {
+				GameState GameState `json:"gameState"`
+			} `json:"games"`
+		}
+		if err := json.Unmarshal(body, &scores); err != nil {
+			return 0
+		}
+		// A day without games may still have games scheduled into it
+		if len(scores.Games) == 0 {
+			return ScheduledTTL
+		}
+		ttl := NoExpiry
+		for _, game := range scores.Games {
+			ttl = shorterTTL(ttl, gameStateTTL(game.GameState))
+		}
+		return ttl
+	case strings.HasSuffix(path, "/scoreboard/now"):
+		return LiveTTL
+	case strings.HasSuffix(path, "/standings/now"):
+		return StandingsTTL
+	case standingsDatePath.MatchString(path), strings.Contains(path, "/roster/"), strings.Contains(path, "/roster-season/"):
+		return RosterTTL
+	case strings.Contains(path, "/club-schedule-season/"),
+		strings.Contains(path, "/club-stats"),
+		strings.Contains(path, "/draft/"),
+		strings.Contains(path, "-stats-leaders/"),
+		strings.Contains(path, "/player/"),
+		strings.HasSuffix(path, "/search/player"),
+		strings.Contains(path, "/content/"):
+		return StatsTTL
+	default:
+		return ScheduledTTL
+	}
+}
+
+// gameStateTTL returns how long data for a game in state may be cached
+func gameStateTTL(state GameState) time.Duration {
+	switch {
+	case state.IsFinal():
+		return NoExpiry
+	case state.IsLive():
+		return LiveTTL
+	default:
+		return ScheduledTTL
+	}
+}
+
+// shorterTTL returns the shorter of two TTLs, treating NoExpiry as infinite
+func shorterTTL(a, b time.Duration) time.Duration {
+	if a == NoExpiry {
+		return b
+	}
+	if b == NoExpiry || a < b {
+		return a
+	}
+	return b
+}
+
+// expiry returns the expiry time for ttl, or the zero time for NoExpiry
+func expiry(ttl time.Duration) time.Time {
+	if ttl == NoExpiry {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
+
+// expired reports whether an entry expiring at expires has expired
+func expired(expires time.Time) bool {
+	return !expires.IsZero() && time.Now().After(expires)
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entries once it holds more than its maximum number of entries
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // front is most recently used
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates an in-memory LRU cache holding up to maxEntries
+// responses. A maxEntries of 0 or less means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get implements Cache
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*memoryEntry)
+	if expired(entry.expires) {
+		m.remove(elem)
+		return nil, false
+	}
+	m.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set implements Cache
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		entry := elem.Value.(*memoryEntry)
+		entry.value = value
+		entry.expires = expiry(ttl)
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, value: value, expires: expiry(ttl)})
+	for m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete implements Cache
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Purge implements Cache
+func (m *MemoryCache) Purge() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[string]*list.Element)
+	m.order.Init()
+}
+
+// Len returns the number of cached entries, including expired ones not yet evicted
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// remove deletes elem. The caller must hold m.mu.
+func (m *MemoryCache) remove(elem *list.Element) {
+	m.order.Remove(elem)
+	delete(m.entries, elem.Value.(*memoryEntry).key)
+}
+
+// DiskCache is a Cache storing one file per response in a directory,
+// so cached data survives restarts
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a cache in dir, creating the directory if needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get implements Cache
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+
+	var expires time.Time
+	if nanos := int64(binary.BigEndian.Uint64(data[:8])); nanos != 0 {
+		expires = time.Unix(0, nanos)
+	}
+	if expired(expires) {
+		d.Delete(key)
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Set implements Cache. Entries are written atomically so concurrent
+// readers never see a partial file.
+func (d *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	data := make([]byte, 8+len(value))
+	if expires := expiry(ttl); !expires.IsZero() {
+		binary.BigEndian.PutUint64(data[:8], uint64(expires.UnixNano()))
+	}
+	copy(data[8:], value)
+
+	writeFileAtomic(d.path(key), data)
+}
+
+// Delete implements Cache
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+// Purge implements Cache
+func (d *DiskCache) Purge() {
+	files, err := filepath.Glob(filepath.Join(d.dir, "*.cache"))
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		os.Remove(file)
+	}
+}
+
+// path returns the file holding key
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".cache")
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames
+// it into place
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
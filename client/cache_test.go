@@ -0,0 +1,212 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", []byte("1"), NoExpiry)
+	cache.Set("b", []byte("2"), NoExpiry)
+	if _, ok := cache.Get("a"); !ok { // a is now most recently used
+		t.Fatal("Get(a) missing")
+	}
+	cache.Set("c", []byte("3"), NoExpiry) // evicts b
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Get(b) should have been evicted")
+	}
+	if v, ok := cache.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("Get(a) = %s, %v, want 1, true", v, ok)
+	}
+	if v, ok := cache.Get("c"); !ok || string(v) != "3" {
+		t.Errorf("Get(c) = %s, %v, want 3, true", v, ok)
+	}
+
+	cache.Set("short", []byte("x"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := cache.Get("short"); ok {
+		t.Error("Get(short) should have expired")
+	}
+
+	cache.Delete("a")
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Get(a) should have been deleted")
+	}
+
+	cache.Purge()
+	if cache.Len() != 0 {
+		t.Errorf("Len() after Purge = %d, want 0", cache.Len())
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+
+	cache.Set("https://api-web.nhle.com/v1/standings/now", []byte(`{"standings":[]}`), time.Hour)
+	cache.Set("final", []byte("boxscore"), NoExpiry)
+	cache.Set("short", []byte("x"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	if v, ok := cache.Get("https://api-web.nhle.com/v1/standings/now"); !ok || string(v) != `{"standings":[]}` {
+		t.Errorf("Get(standings) = %s, %v", v, ok)
+	}
+	if v, ok := cache.Get("final"); !ok || string(v) != "boxscore" {
+		t.Errorf("Get(final) = %s, %v", v, ok)
+	}
+	if _, ok := cache.Get("short"); ok {
+		t.Error("Get(short) should have expired")
+	}
+
+	// A second cache over the same directory sees the stored entries
+	reopened, err := NewDiskCache(cache.dir)
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+	if _, ok := reopened.Get("final"); !ok {
+		t.Error("reopened cache missing entry")
+	}
+
+	cache.Purge()
+	if _, ok := reopened.Get("final"); ok {
+		t.Error("Get(final) should be gone after Purge")
+	}
+}
+
+func TestDefaultCachePolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		body string
+		want time.Duration
+	}{
+		{
+			name: "Final game boxscore",
+			url:  BaseURLWeb + "/gamecenter/2023020204/boxscore",
+			body: `{"gameState":"OFF"}`,
+			want: NoExpiry,
+		},
+		{
+			name: "Live play-by-play",
+			url:  BaseURLWeb + "/gamecenter/2023020204/play-by-play",
+			body: `{"gameState":"LIVE"}`,
+			want: LiveTTL,
+		},
+		{
+			name: "Future game landing",
+			url:  BaseURLWeb + "/gamecenter/2023020204/landing",
+			body: `{"gameState":"FUT"}`,
+			want: ScheduledTTL,
+		},
+		{
+			name: "Scoreboard now",
+			url:  BaseURLWeb + "/scoreboard/now",
+			body: `{}`,
+			want: LiveTTL,
+		},
+		{
+			name: "Finished day of scores",
+			url:  BaseURLWeb + "/score/2024-02-09?sort=desc",
+			body: `{"games":[{"gameState":"OFF"},{"gameState":"FINAL"}]}`,
+			want: NoExpiry,
+		},
+		{
+			name: "Day of scores with a live game",
+			url:  BaseURLWeb + "/score/2024-02-09",
+			body: `{"games":[{"gameState":"OFF"},{"gameState":"LIVE"},{"gameState":"FUT"}]}`,
+			want: LiveTTL,
+		},
+		{
+			name: "Day of scores without games",
+			url:  BaseURLWeb + "/score/2024-07-01",
+			body: `{"games":[]}`,
+			want: ScheduledTTL,
+		},
+		{
+			name: "Current standings",
+			url:  BaseURLWeb + "/standings/now",
+			body: `{}`,
+			want: StandingsTTL,
+		},
+		{
+			name: "Standings by date",
+			url:  BaseURLWeb + "/standings/2024-02-01",
+			body: `{}`,
+			want: RosterTTL,
+		},
+		{
+			name: "Roster",
+			url:  BaseURLWeb + "/roster/TOR/current",
+			body: `{}`,
+			want: RosterTTL,
+		},
+		{
+			name: "Club stats",
+			url:  BaseURLWeb + "/club-stats/NYR/20232024/2",
+			body: `{}`,
+			want: StatsTTL,
+		},
+		{
+			name: "Unreadable game body",
+			url:  BaseURLWeb + "/gamecenter/2023020204/boxscore",
+			body: `not json`,
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultCachePolicy(tt.url, []byte(tt.body)); got != tt.want {
+				t.Errorf("DefaultCachePolicy(%s) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientGetCache(t *testing.T) {
+	requests := 0
+	cache := NewMemoryCache(10)
+	client := NewClient(
+		WithCache(cache),
+		WithHTTPClient(&mockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requests++
+				return mockResponse(http.StatusOK, map[string]string{"gameState": "OFF"})
+			},
+		}),
+	)
+	url := BaseURLWeb + "/gamecenter/2023020204/boxscore"
+	ctx := context.Background()
+
+	var result map[string]string
+	for i := 0; i < 3; i++ {
+		if err := client.get(ctx, url, &result); err != nil {
+			t.Fatalf("get() error = %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1 with caching", requests)
+	}
+
+	if err := client.get(BypassCache(ctx), url, &result); err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("made %d requests, want 2 after bypassing the cache", requests)
+	}
+
+	client.PurgeCache()
+	if err := client.get(ctx, url, &result); err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("made %d requests, want 3 after purging the cache", requests)
+	}
+}
@@ -0,0 +1,148 @@
+package nhl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cayenne is a filter expression for the stats REST API, such as
+// seasonId=20232024 and gameTypeId=2. The zero value matches every row.
+type Cayenne struct {
+	expr string
+	or   bool // joined with or, so it needs parentheses inside an and
+}
+
+// Eq matches rows whose field equals value
+func Eq(field string, value any) Cayenne {
+	return compare(field, "=", value)
+}
+
+// Ne matches rows whose field does not equal value
+func Ne(field string, value any) Cayenne {
+	return compare(field, "!=", value)
+}
+
+// Gt matches rows whose field is greater than value
+func Gt(field string, value any) Cayenne {
+	return compare(field, ">", value)
+}
+
+// Ge matches rows whose field is at least value
+func Ge(field string, value any) Cayenne {
+	return compare(field, ">=", value)
+}
+
+// Lt matches rows whose field is less than value
+func Lt(field string, value any) Cayenne {
+	return compare(field, "<", value)
+}
+
+// Le matches rows whose field is at most value
+func Le(field string, value any) Cayenne {
+	return compare(field, "<=", value)
+}
+
+// Like matches rows whose field contains substr, ignoring case
+func Like(field, substr string) Cayenne {
+	return Cayenne{expr: fmt.Sprintf("%s likeIgnoreCase %s", field, strconv.Quote("%"+substr+"%"))}
+}
+
+// SeasonIs matches rows for a season, such as 20232024
+func SeasonIs(seasonID int) Cayenne {
+	return Eq("seasonId", seasonID)
+}
+
+// SeasonsBetween matches rows for the seasons from first to last inclusive
+func SeasonsBetween(first, last int) Cayenne {
+	return And(Ge("seasonId", first), Le("seasonId", last))
+}
+
+// GameTypeIs matches rows for a game type
+func GameTypeIs(gameType GameType) Cayenne {
+	return Eq("gameTypeId", gameType)
+}
+
+// And matches rows every expression matches, skipping empty ones
+func And(exprs ...Cayenne) Cayenne {
+	return join(" and ", false, exprs)
+}
+
+// Or matches rows any expression matches, skipping empty ones
+func Or(exprs ...Cayenne) Cayenne {
+	return join(" or ", true, exprs)
+}
+
+// IsZero reports whether the expression is empty
+func (c Cayenne) IsZero() bool {
+	return c.expr == ""
+}
+
+// String returns the expression as the API expects it
+func (c Cayenne) String() string {
+	return c.expr
+}
+
+// compare builds a field op value expression, quoting strings
+func compare(field, op string, value any) Cayenne {
+	var v string
+	switch value := value.(type) {
+	case string:
+		v = strconv.Quote(value)
+	case GameType:
+		v = strconv.Itoa(int(value))
+	default:
+		v = fmt.Sprint(value)
+	}
+	return Cayenne{expr: field + op + v}
+}
+
+// join joins the non-empty expressions with sep
+func join(sep string, or bool, exprs []Cayenne) Cayenne {
+	var parts []string
+	for _, e := range exprs {
+		if e.IsZero() {
+			continue
+		}
+		if e.or && !or {
+			parts = append(parts, "("+e.expr+")")
+		} else {
+			parts = append(parts, e.expr)
+		}
+	}
+	if len(parts) == 0 {
+		return Cayenne{}
+	}
+	return Cayenne{expr: strings.Join(parts, sep), or: or && len(parts) > 1}
+}
+
+// SortDirection is the order a stats REST sort property is sorted in
+type SortDirection string
+
+const (
+	Ascending  SortDirection = "ASC"
+	Descending SortDirection = "DESC"
+)
+
+// StatsSort sorts stats REST rows by a property
+type StatsSort struct {
+	Property  string        `json:"property"`
+	Direction SortDirection `json:"direction"`
+}
+
+// Asc sorts by property, smallest first
+func Asc(property string) StatsSort {
+	return StatsSort{Property: property, Direction: Ascending}
+}
+
+// Desc sorts by property, largest first
+func Desc(property string) StatsSort {
+	return StatsSort{Property: property, Direction: Descending}
+}
+
+// encodeSorts encodes sorts as the JSON list the API expects
+func encodeSorts(sorts []StatsSort) string {
+	b, _ := json.Marshal(sorts)
+	return string(b)
+}
@@ -0,0 +1,33 @@
+package nhl
+
+import "testing"
+
+func TestCayenne(t *testing.T) {
+	tests := []struct {
+		name string
+		expr Cayenne
+		want string
+	}{
+		{name: "Empty", expr: Cayenne{}, want: ""},
+		{name: "Number", expr: Eq("gameId", 2024020750), want: "gameId=2024020750"},
+		{name: "String", expr: Ne("positionCode", "D"), want: `positionCode!="D"`},
+		{name: "Game type", expr: GameTypeIs(GameTypePlayoffs), want: "gameTypeId=3"},
+		{name: "Like", expr: Like("skaterFullName", "mcdavid"), want: `skaterFullName likeIgnoreCase "%mcdavid%"`},
+		{name: "Seasons", expr: SeasonsBetween(20202021, 20232024), want: "seasonId>=20202021 and seasonId<=20232024"},
+		{name: "And skips empty", expr: And(Cayenne{}, SeasonIs(20232024), Cayenne{}), want: "seasonId=20232024"},
+		{
+			name: "Or inside and",
+			expr: And(SeasonIs(20232024), Or(Eq("positionCode", "C"), Eq("positionCode", "L")), Gt("points", 50)),
+			want: `seasonId=20232024 and (positionCode="C" or positionCode="L") and points>50`,
+		},
+		{name: "Single or", expr: And(Or(Lt("gamesPlayed", 10)), Le("age", 21)), want: "gamesPlayed<10 and age<=21"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.expr.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
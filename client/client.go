@@ -1,8 +1,10 @@
 package nhl
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -15,43 +17,167 @@ type HTTPClient interface {
 
 // Client represents an NHL API client
 type Client struct {
-	baseURL    string
-	httpClient HTTPClient
-	teams      *TeamsResponse // Cache of teams
-	cacheMutex sync.RWMutex
+	baseURL       string
+	forgeBaseURL  string
+	statsBaseURL  string
+	searchBaseURL string
+	httpClient    HTTPClient
+	timeout       time.Duration
+	headers       http.Header
+	userAgent     string
+	retryPolicy   RetryPolicy
+	retryHook     RetryHook
+	limiter       rateLimiter
+	cache         Cache
+	cachePolicy   CachePolicy
+	teams         *teamDirectory // Cache of the team directory
+	teamsLoad     *directoryLoad // Team directory load in progress, if any
+	teamsTTL      time.Duration
+	players       *playerIndex // Players seen in search results
+	cacheMutex    sync.RWMutex
 }
 
-// NewClient creates a new NHL API client
-func NewClient() *Client {
-	return &Client{
-		baseURL: BaseURLWeb,
-		httpClient: &http.Client{
-			Timeout: time.Second * 30,
-		},
+// NewClient creates a new NHL API client configured by opts
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:       BaseURLWeb,
+		forgeBaseURL:  BaseURLForge,
+		statsBaseURL:  BaseURLStats,
+		searchBaseURL: BaseURLSearch,
+		httpClient:    &http.Client{},
+		timeout:       DefaultTimeout,
+		retryPolicy:   DefaultRetryPolicy(),
+		cachePolicy:   DefaultCachePolicy,
+		teamsTTL:      DefaultTeamsTTL,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-// get performs a GET request and unmarshals the response into v
-func (c *Client) get(url string, v interface{}) error {
-	req, err := http.NewRequest("GET", url, nil)
+// get performs a GET request and unmarshals the response into v.
+// The request is bound to ctx, so cancelling ctx aborts the call.
+// Responses are served from and stored in the client's cache, if any.
+func (c *Client) get(ctx context.Context, url string, v interface{}) error {
+	if c.cache != nil && !cacheBypassed(ctx) {
+		if body, ok := c.cache.Get(url); ok {
+			if err := json.Unmarshal(body, v); err == nil {
+				return nil
+			}
+			// Drop unreadable entries and fall through to the network
+			c.cache.Delete(url)
+		}
+	}
+
+	body, err := c.fetch(ctx, http.MethodGet, url)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, v)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+		return decodeError(url, err)
+	}
+
+	if c.cache != nil && c.cachePolicy != nil {
+		if ttl := c.cachePolicy(url, body); ttl != 0 {
+			c.cache.Set(url, body, ttl)
+		}
+	}
+
+	return nil
+}
+
+// fetch performs a request and returns the response body, retrying
+// transient failures according to the client's retry policy
+func (c *Client) fetch(ctx context.Context, method, url string) ([]byte, error) {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		body, resp, err := c.do(ctx, method, url)
+		if err == nil {
+			return body, nil
+		}
+
+		// Never retry once the caller has given up, or for non-idempotent requests
+		if ctx.Err() != nil || !isIdempotent(method) || attempt >= c.retryPolicy.MaxAttempts {
+			return nil, err
+		}
+
+		statusCode := 0
+		wait := c.retryPolicy.backoff(attempt)
+		if resp != nil {
+			statusCode = resp.StatusCode
+			if !c.retryPolicy.retryable(statusCode) {
+				return nil, err
+			}
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				wait = retryAfter
+			}
+		}
+
+		if c.retryPolicy.MaxElapsed > 0 && time.Since(start)+wait > c.retryPolicy.MaxElapsed {
+			return nil, err
+		}
+
+		if c.retryHook != nil {
+			c.retryHook(RetryEvent{
+				URL:        url,
+				Attempt:    attempt,
+				StatusCode: statusCode,
+				Err:        err,
+				Wait:       wait,
+			})
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do performs a single request attempt. On a non-200 status the response
+// is returned alongside the error so the caller can inspect it.
+func (c *Client) do(ctx context.Context, method, url string) ([]byte, *http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Wait for the rate limiter before starting the request timeout
+	if err := c.limiter.wait(ctx, req.URL.Host); err != nil {
+		return nil, nil, fmt.Errorf("rate limiter: %w", err)
+	}
+	if c.timeout > 0 {
+		timeoutCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+		req = req.WithContext(timeoutCtx)
+	}
+
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %v", err)
+		return nil, nil, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxBodyExcerpt*4))
+		return nil, resp, newAPIError(url, resp.StatusCode, excerpt)
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(v)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to decode response: %v", err)
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	return nil
+	return body, resp, nil
 }
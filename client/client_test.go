@@ -2,11 +2,13 @@ package nhl
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 )
 
 // mockHTTPClient is a mock implementation of HTTPClient for testing
@@ -39,12 +41,16 @@ func TestNewClient(t *testing.T) {
 	if client.baseURL != BaseURLWeb {
 		t.Errorf("NewClient().baseURL = %v, want %v", client.baseURL, BaseURLWeb)
 	}
+	if client.forgeBaseURL != BaseURLForge {
+		t.Errorf("NewClient().forgeBaseURL = %v, want %v", client.forgeBaseURL, BaseURLForge)
+	}
 	if client.httpClient == nil {
 		t.Error("NewClient().httpClient is nil")
 	}
 }
 
 func TestClientGet(t *testing.T) {
+	ctx := context.Background()
 	type testStruct struct {
 		Field string `json:"field"`
 	}
@@ -94,7 +100,7 @@ func TestClientGet(t *testing.T) {
 			}
 
 			var result testStruct
-			err := client.get(tt.url, &result)
+			err := client.get(ctx, tt.url, &result)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -109,3 +115,66 @@ func TestClientGet(t *testing.T) {
 		})
 	}
 }
+
+func TestClientGetContextCanceled(t *testing.T) {
+	client := NewClient()
+	client.httpClient = &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if err := req.Context().Err(); err != nil {
+				return nil, err
+			}
+			return mockResponse(http.StatusOK, map[string]string{"field": "value"})
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var result map[string]string
+	if err := client.get(ctx, "https://api.example.com/test", &result); err == nil {
+		t.Error("get() with canceled context should return error")
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	var gotReq *http.Request
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotReq = req
+			return mockResponse(http.StatusOK, map[string]string{})
+		},
+	}
+
+	client := NewClient(
+		WithBaseURL("http://localhost:8080/v1/"),
+		WithForgeBaseURL("http://localhost:8081"),
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithHeader("X-Api-Key", "secret"),
+		WithUserAgent("nhl-test/1.0"),
+	)
+
+	if client.baseURL != "http://localhost:8080/v1" {
+		t.Errorf("baseURL = %v, want %v", client.baseURL, "http://localhost:8080/v1")
+	}
+	if client.forgeBaseURL != "http://localhost:8081" {
+		t.Errorf("forgeBaseURL = %v, want %v", client.forgeBaseURL, "http://localhost:8081")
+	}
+	if client.timeout != 5*time.Second {
+		t.Errorf("timeout = %v, want %v", client.timeout, 5*time.Second)
+	}
+
+	var result map[string]string
+	if err := client.get(context.Background(), client.baseURL+"/test", &result); err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if got := gotReq.Header.Get("X-Api-Key"); got != "secret" {
+		t.Errorf("X-Api-Key header = %v, want %v", got, "secret")
+	}
+	if got := gotReq.Header.Get("User-Agent"); got != "nhl-test/1.0" {
+		t.Errorf("User-Agent header = %v, want %v", got, "nhl-test/1.0")
+	}
+	if _, ok := gotReq.Context().Deadline(); !ok {
+		t.Error("request context has no deadline")
+	}
+}
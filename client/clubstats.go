@@ -0,0 +1,202 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"go-nhl/internal/formatters"
+	"sort"
+	"strings"
+)
+
+// ClubStats is a club's player stats for one season and game type
+type ClubStats struct {
+	Season   string            `json:"season"`
+	GameType GameType          `json:"gameType"`
+	Skaters  []ClubSkaterStats `json:"skaters"`
+	Goalies  []ClubGoalieStats `json:"goalies"`
+}
+
+// ClubSkaterStats is a skater's line in a club's stats
+type ClubSkaterStats struct {
+	PlayerID            int           `json:"playerId"`
+	Headshot            string        `json:"headshot"`
+	FirstName           LanguageNames `json:"firstName"`
+	LastName            LanguageNames `json:"lastName"`
+	PositionCode        Position      `json:"positionCode"`
+	GamesPlayed         int           `json:"gamesPlayed"`
+	Goals               int           `json:"goals"`
+	Assists             int           `json:"assists"`
+	Points              int           `json:"points"`
+	PlusMinus           int           `json:"plusMinus"`
+	PenaltyMinutes      int           `json:"penaltyMinutes"`
+	PowerPlayGoals      int           `json:"powerPlayGoals"`
+	ShorthandedGoals    int           `json:"shorthandedGoals"`
+	GameWinningGoals    int           `json:"gameWinningGoals"`
+	OvertimeGoals       int           `json:"overtimeGoals"`
+	Shots               int           `json:"shots"`
+	ShootingPctg        float64       `json:"shootingPctg"`
+	AvgTimeOnIcePerGame float64       `json:"avgTimeOnIcePerGame"` // seconds
+	AvgShiftsPerGame    float64       `json:"avgShiftsPerGame"`
+	FaceoffWinPctg      float64       `json:"faceoffWinPctg"`
+}
+
+// ClubGoalieStats is a goalie's line in a club's stats
+type ClubGoalieStats struct {
+	PlayerID            int           `json:"playerId"`
+	Headshot            string        `json:"headshot"`
+	FirstName           LanguageNames `json:"firstName"`
+	LastName            LanguageNames `json:"lastName"`
+	GamesPlayed         int           `json:"gamesPlayed"`
+	GamesStarted        int           `json:"gamesStarted"`
+	Wins                int           `json:"wins"`
+	Losses              int           `json:"losses"`
+	OvertimeLosses      int           `json:"overtimeLosses"`
+	GoalsAgainstAverage float64       `json:"goalsAgainstAverage"`
+	SavePercentage      float64       `json:"savePercentage"`
+	ShotsAgainst        int           `json:"shotsAgainst"`
+	Saves               int           `json:"saves"`
+	GoalsAgainst        int           `json:"goalsAgainst"`
+	Shutouts            int           `json:"shutouts"`
+	Goals               int           `json:"goals"`
+	Assists             int           `json:"assists"`
+	Points              int           `json:"points"`
+	PenaltyMinutes      int           `json:"penaltyMinutes"`
+	TimeOnIce           int           `json:"timeOnIce"` // seconds
+}
+
+// ClubStatsSeason is a season a club has stats for, with the game types
+// played in it
+type ClubStatsSeason struct {
+	Season    int        `json:"season"`
+	GameTypes []GameType `json:"gameTypes"`
+}
+
+// GetClubStats returns the stats of every skater and goalie who played for
+// a team in a season and game type. If seasonID is 0 the current season is
+// used, and if gameType is 0 the regular season.
+func (c *Client) GetClubStats(ctx context.Context, identifier string, seasonID int, gameType GameType) (*ClubStats, error) {
+	team, err := c.GetTeamByIdentifier(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if seasonID == 0 {
+		seasonID = formatters.GetCurrentSeasonID()
+	}
+	if gameType == 0 {
+		gameType = GameTypeRegularSeason
+	}
+
+	url := fmt.Sprintf("%s/club-stats/%s/%d/%d", c.baseURL, team.Abbreviation, seasonID, gameType)
+	var response ClubStats
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get club stats: %w", err)
+	}
+	return &response, nil
+}
+
+// GetClubStatsSeasons returns the seasons a team has club stats for, oldest
+// first
+func (c *Client) GetClubStatsSeasons(ctx context.Context, identifier string) ([]ClubStatsSeason, error) {
+	team, err := c.GetTeamByIdentifier(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/club-stats-season/%s", c.baseURL, team.Abbreviation)
+	var response []ClubStatsSeason
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get club stats seasons: %w", err)
+	}
+	sort.Slice(response, func(i, j int) bool {
+		return response[i].Season < response[j].Season
+	})
+	return response, nil
+}
+
+// clubStatsSort orders skaters and goalies by one stat. A nil function
+// leaves that list in its current order.
+type clubStatsSort struct {
+	skaters func(a, b ClubSkaterStats) bool
+	goalies func(a, b ClubGoalieStats) bool
+}
+
+// clubStatsSorts maps each sort key to its ordering, best first
+var clubStatsSorts = map[string]clubStatsSort{
+	"name": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.LastName.Default < b.LastName.Default },
+		goalies: func(a, b ClubGoalieStats) bool { return a.LastName.Default < b.LastName.Default },
+	},
+	"gamesPlayed": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.GamesPlayed > b.GamesPlayed },
+		goalies: func(a, b ClubGoalieStats) bool { return a.GamesPlayed > b.GamesPlayed },
+	},
+	"goals": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.Goals > b.Goals },
+	},
+	"assists": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.Assists > b.Assists },
+	},
+	"points": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.Points > b.Points },
+	},
+	"plusMinus": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.PlusMinus > b.PlusMinus },
+	},
+	"penaltyMinutes": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.PenaltyMinutes > b.PenaltyMinutes },
+		goalies: func(a, b ClubGoalieStats) bool { return a.PenaltyMinutes > b.PenaltyMinutes },
+	},
+	"powerPlayGoals": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.PowerPlayGoals > b.PowerPlayGoals },
+	},
+	"shots": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.Shots > b.Shots },
+	},
+	"timeOnIce": {
+		skaters: func(a, b ClubSkaterStats) bool { return a.AvgTimeOnIcePerGame > b.AvgTimeOnIcePerGame },
+		goalies: func(a, b ClubGoalieStats) bool { return a.TimeOnIce > b.TimeOnIce },
+	},
+	"wins": {
+		goalies: func(a, b ClubGoalieStats) bool { return a.Wins > b.Wins },
+	},
+	"savePercentage": {
+		goalies: func(a, b ClubGoalieStats) bool { return a.SavePercentage > b.SavePercentage },
+	},
+	"goalsAgainstAverage": {
+		goalies: func(a, b ClubGoalieStats) bool { return a.GoalsAgainstAverage < b.GoalsAgainstAverage },
+	},
+	"shutouts": {
+		goalies: func(a, b ClubGoalieStats) bool { return a.Shutouts > b.Shutouts },
+	},
+}
+
+// ClubStatsSortKeys returns the keys Sort accepts, alphabetically
+func ClubStatsSortKeys() []string {
+	keys := make([]string, 0, len(clubStatsSorts))
+	for key := range clubStatsSorts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Sort orders the skaters and goalies by a stat, best first. Keys name a
+// stat field such as points or savePercentage; a key that applies to only
+// skaters or only goalies leaves the other list as it is.
+func (s *ClubStats) Sort(key string) error {
+	order, ok := clubStatsSorts[key]
+	if !ok {
+		return fmt.Errorf("unknown club stats sort %q, want one of: %s", key, strings.Join(ClubStatsSortKeys(), ", "))
+	}
+	if order.skaters != nil {
+		sort.SliceStable(s.Skaters, func(i, j int) bool {
+			return order.skaters(s.Skaters[i], s.Skaters[j])
+		})
+	}
+	if order.goalies != nil {
+		sort.SliceStable(s.Goalies, func(i, j int) bool {
+			return order.goalies(s.Goalies[i], s.Goalies[j])
+		})
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetClubStats(t *testing.T) {
+	var paths []string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch {
+			case strings.HasSuffix(req.URL.Path, "/club-stats/NYR/20232024/3"):
+				paths = append(paths, req.URL.Path)
+				return mockResponse(http.StatusOK, ClubStats{
+					Season:   "20232024",
+					GameType: GameTypePlayoffs,
+					Skaters:  []ClubSkaterStats{{PlayerID: 8478550, Points: 15}},
+				})
+			case strings.HasSuffix(req.URL.Path, "/club-stats-season/NYR"):
+				paths = append(paths, req.URL.Path)
+				return mockResponse(http.StatusOK, []ClubStatsSeason{
+					{Season: 20232024, GameTypes: []GameType{GameTypeRegularSeason, GameTypePlayoffs}},
+					{Season: 20222023, GameTypes: []GameType{GameTypeRegularSeason}},
+				})
+			}
+			// The team directory falls back to its built-in teams
+			return mockResponse(http.StatusNotFound, nil)
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+	ctx := context.Background()
+
+	stats, err := client.GetClubStats(ctx, "New York Rangers", 20232024, GameTypePlayoffs)
+	if err != nil {
+		t.Fatalf("GetClubStats() error = %v", err)
+	}
+	if stats.GameType != GameTypePlayoffs || len(stats.Skaters) != 1 || stats.Skaters[0].Points != 15 {
+		t.Errorf("GetClubStats() = %+v, want Panarin's playoff stats", stats)
+	}
+
+	seasons, err := client.GetClubStatsSeasons(ctx, "NYR")
+	if err != nil {
+		t.Fatalf("GetClubStatsSeasons() error = %v", err)
+	}
+	if len(seasons) != 2 || seasons[0].Season != 20222023 {
+		t.Errorf("GetClubStatsSeasons() = %+v, want 20222023 first", seasons)
+	}
+
+	if len(paths) != 2 {
+		t.Errorf("requested %v, want club stats and seasons", paths)
+	}
+}
+
+func TestClubStatsSort(t *testing.T) {
+	stats := &ClubStats{
+		Skaters: []ClubSkaterStats{
+			{PlayerID: 1, LastName: LanguageNames{Default: "Zibanejad"}, Goals: 26, Points: 72},
+			{PlayerID: 2, LastName: LanguageNames{Default: "Panarin"}, Goals: 49, Points: 120},
+			{PlayerID: 3, LastName: LanguageNames{Default: "Kreider"}, Goals: 39, Points: 75},
+		},
+		Goalies: []ClubGoalieStats{
+			{PlayerID: 4, LastName: LanguageNames{Default: "Shesterkin"}, GoalsAgainstAverage: 2.58, Wins: 36},
+			{PlayerID: 5, LastName: LanguageNames{Default: "Quick"}, GoalsAgainstAverage: 2.62, Wins: 18},
+		},
+	}
+
+	tests := []struct {
+		key         string
+		wantSkaters []int
+		wantGoalies []int
+	}{
+		{key: "points", wantSkaters: []int{2, 3, 1}, wantGoalies: []int{4, 5}},
+		{key: "name", wantSkaters: []int{3, 2, 1}, wantGoalies: []int{5, 4}},
+		{key: "goalsAgainstAverage", wantSkaters: []int{3, 2, 1}, wantGoalies: []int{4, 5}},
+		{key: "goals", wantSkaters: []int{2, 3, 1}, wantGoalies: []int{4, 5}},
+	}
+
+	for _, tt := range tests {
+		if err := stats.Sort(tt.key); err != nil {
+			t.Fatalf("Sort(%q) error = %v", tt.key, err)
+		}
+		var skaters, goalies []int
+		for _, skater := range stats.Skaters {
+			skaters = append(skaters, skater.PlayerID)
+		}
+		for _, goalie := range stats.Goalies {
+			goalies = append(goalies, goalie.PlayerID)
+		}
+		if !equalInts(skaters, tt.wantSkaters) || !equalInts(goalies, tt.wantGoalies) {
+			t.Errorf("Sort(%q) = %v %v, want %v %v", tt.key, skaters, goalies, tt.wantSkaters, tt.wantGoalies)
+		}
+	}
+
+	if err := stats.Sort("hits"); err == nil {
+		t.Error("Sort() with an unknown key returned no error")
+	}
+}
@@ -4,6 +4,7 @@ package nhl
 type GameType int
 
 const (
+	GameTypePreseason     GameType = 1
 	GameTypeRegularSeason GameType = 2
 	GameTypePlayoffs      GameType = 3
 	GameTypeAllStar       GameType = 4
@@ -19,5 +20,8 @@ const (
 
 // Base URLs for NHL API
 const (
-	BaseURLWeb = "https://api-web.nhle.com/v1"
+	BaseURLWeb    = "https://api-web.nhle.com/v1"
+	BaseURLForge  = "https://forge-dapi.d3.nhle.com/v2"
+	BaseURLStats  = "https://api.nhle.com/stats/rest/en"
+	BaseURLSearch = "https://search.d3.nhle.com/api/v1"
 )
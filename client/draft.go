@@ -0,0 +1,203 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"go-nhl/internal/formatters"
+	"strings"
+	"time"
+)
+
+// DraftRankingCategory is one of NHL Central Scouting's prospect lists
+type DraftRankingCategory int
+
+const (
+	DraftRankingNorthAmericanSkaters DraftRankingCategory = 1
+	DraftRankingInternationalSkaters DraftRankingCategory = 2
+	DraftRankingNorthAmericanGoalies DraftRankingCategory = 3
+	DraftRankingInternationalGoalies DraftRankingCategory = 4
+)
+
+// draftRankingKeys maps each prospect list to the key the API names it by
+var draftRankingKeys = map[DraftRankingCategory]string{
+	DraftRankingNorthAmericanSkaters: "north-american-skater",
+	DraftRankingInternationalSkaters: "international-skater",
+	DraftRankingNorthAmericanGoalies: "north-american-goalie",
+	DraftRankingInternationalGoalies: "international-goalie",
+}
+
+// ParseDraftRankingCategory parses a prospect list key such as
+// north-american-skater
+func ParseDraftRankingCategory(key string) (DraftRankingCategory, error) {
+	for category, k := range draftRankingKeys {
+		if strings.EqualFold(key, k) {
+			return category, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown draft ranking category %q, want north-american-skater, international-skater, north-american-goalie or international-goalie", key)
+}
+
+// String returns the category's key
+func (c DraftRankingCategory) String() string {
+	if key, ok := draftRankingKeys[c]; ok {
+		return key
+	}
+	return fmt.Sprintf("DraftRankingCategory(%d)", int(c))
+}
+
+// DraftPicks is the picks made in one draft
+type DraftPicks struct {
+	DraftYear        int         `json:"draftYear"`
+	SelectableRounds []int       `json:"selectableRounds"`
+	State            string      `json:"state"`
+	Picks            []DraftPick `json:"picks"`
+}
+
+// DraftPick is a player selected in a draft
+type DraftPick struct {
+	Round           int           `json:"round"`
+	PickInRound     int           `json:"pickInRound"`
+	OverallPick     int           `json:"overallPick"`
+	TeamID          int           `json:"teamId"`
+	TeamAbbrev      string        `json:"teamAbbrev"`
+	TeamName        LanguageNames `json:"teamName"`
+	TeamLogo        string        `json:"teamLogoLight"`
+	TeamPickHistory string        `json:"teamPickHistory"` // Teams that owned the pick, e.g. "BOS-NYR"
+	FirstName       LanguageNames `json:"firstName"`
+	LastName        LanguageNames `json:"lastName"`
+	PositionCode    Position      `json:"positionCode"`
+	CountryCode     string        `json:"countryCode"`
+	Height          int           `json:"height"` // inches
+	Weight          int           `json:"weight"` // pounds
+	AmateurLeague   string        `json:"amateurLeague"`
+	AmateurClubName string        `json:"amateurClubName"`
+}
+
+// DraftRankings is one of Central Scouting's prospect lists for a draft
+type DraftRankings struct {
+	DraftYear   int             `json:"draftYear"`
+	CategoryID  int             `json:"categoryId"`
+	CategoryKey string          `json:"categoryKey"`
+	DraftYears  []int           `json:"draftYears"`
+	Rankings    []DraftProspect `json:"rankings"`
+}
+
+// DraftProspect is a ranked draft prospect. Ranks are 0 when the prospect
+// was not on that list.
+type DraftProspect struct {
+	FirstName          string   `json:"firstName"`
+	LastName           string   `json:"lastName"`
+	PositionCode       Position `json:"positionCode"`
+	ShootsCatches      string   `json:"shootsCatches"`
+	HeightInInches     int      `json:"heightInInches"`
+	WeightInPounds     int      `json:"weightInPounds"`
+	LastAmateurClub    string   `json:"lastAmateurClub"`
+	LastAmateurLeague  string   `json:"lastAmateurLeague"`
+	BirthDate          string   `json:"birthDate"`
+	BirthCity          string   `json:"birthCity"`
+	BirthStateProvince string   `json:"birthStateProvince,omitempty"`
+	BirthCountry       string   `json:"birthCountry"`
+	MidtermRank        int      `json:"midtermRank,omitempty"`
+	FinalRank          int      `json:"finalRank,omitempty"`
+}
+
+// DraftDetails is where a player was drafted, from the player landing page
+type DraftDetails struct {
+	Year        int    `json:"year"`
+	TeamAbbrev  string `json:"teamAbbrev"`
+	Round       int    `json:"round"`
+	PickInRound int    `json:"pickInRound"`
+	OverallPick int    `json:"overallPick"`
+}
+
+// GetDraftPicks returns the picks made in a draft year, such as 2015. If
+// year is 0 the most recent draft held is used, and if round is 0 every
+// round is returned.
+func (c *Client) GetDraftPicks(ctx context.Context, year, round int) (*DraftPicks, error) {
+	if year == 0 {
+		year = lastDraftYear(time.Now())
+	}
+	if year < 0 {
+		return nil, fmt.Errorf("invalid draft year: %d", year)
+	}
+	if round < 0 {
+		return nil, fmt.Errorf("invalid draft round: %d", round)
+	}
+
+	rounds := "all"
+	if round > 0 {
+		rounds = fmt.Sprint(round)
+	}
+
+	url := fmt.Sprintf("%s/draft/picks/%d/%s", c.baseURL, year, rounds)
+	var response DraftPicks
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get draft picks: %w", err)
+	}
+	return &response, nil
+}
+
+// lastDraftYear returns the year of the most recent draft held by now. The
+// draft is held in late June, so before July it is last year's.
+func lastDraftYear(now time.Time) int {
+	if now.Month() < time.July {
+		return now.Year() - 1
+	}
+	return now.Year()
+}
+
+// ByTeam returns the picks made by a team, given by abbreviation
+func (d *DraftPicks) ByTeam(abbrev string) []DraftPick {
+	var picks []DraftPick
+	for _, pick := range d.Picks {
+		if strings.EqualFold(pick.TeamAbbrev, abbrev) {
+			picks = append(picks, pick)
+		}
+	}
+	return picks
+}
+
+// Overall returns the pick made at an overall position, or nil
+func (d *DraftPicks) Overall(overallPick int) *DraftPick {
+	for i := range d.Picks {
+		if d.Picks[i].OverallPick == overallPick {
+			return &d.Picks[i]
+		}
+	}
+	return nil
+}
+
+// GetDraftRankings returns a Central Scouting prospect list for a draft
+// year. If year is 0 the current season's upcoming draft is used, and if
+// category is 0 the North American skaters.
+func (c *Client) GetDraftRankings(ctx context.Context, year int, category DraftRankingCategory) (*DraftRankings, error) {
+	if year == 0 {
+		year = formatters.GetCurrentSeasonID() % 10000
+	}
+	if year < 0 {
+		return nil, fmt.Errorf("invalid draft year: %d", year)
+	}
+	if category == 0 {
+		category = DraftRankingNorthAmericanSkaters
+	}
+	if _, ok := draftRankingKeys[category]; !ok {
+		return nil, fmt.Errorf("invalid draft ranking category: %d", category)
+	}
+
+	url := fmt.Sprintf("%s/draft/rankings/%d/%d", c.baseURL, year, category)
+	var response DraftRankings
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get draft rankings: %w", err)
+	}
+	return &response, nil
+}
+
+// GetPlayerDraftDetails returns where a player was drafted, or nil if they
+// were never drafted
+func (c *Client) GetPlayerDraftDetails(ctx context.Context, playerID int) (*DraftDetails, error) {
+	landing, err := c.GetPlayerSeasonStats(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return landing.DraftDetails, nil
+}
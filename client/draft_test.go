@@ -0,0 +1,72 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetDraftPicks(t *testing.T) {
+	var paths []string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return mockResponse(http.StatusOK, DraftPicks{
+				DraftYear: 2015,
+				Picks: []DraftPick{
+					{Round: 1, PickInRound: 1, OverallPick: 1, TeamAbbrev: "EDM", LastName: LanguageNames{Default: "McDavid"}},
+					{Round: 1, PickInRound: 5, OverallPick: 5, TeamAbbrev: "CAR", LastName: LanguageNames{Default: "Hanifin"}},
+					{Round: 2, PickInRound: 5, OverallPick: 35, TeamAbbrev: "CAR", LastName: LanguageNames{Default: "Aho"}},
+				},
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+	ctx := context.Background()
+
+	draft, err := client.GetDraftPicks(ctx, 2015, 0)
+	if err != nil {
+		t.Fatalf("GetDraftPicks() error = %v", err)
+	}
+	if _, err := client.GetDraftPicks(ctx, 2015, 2); err != nil {
+		t.Fatalf("GetDraftPicks() for a round error = %v", err)
+	}
+	if want := []string{"/v1/draft/picks/2015/all", "/v1/draft/picks/2015/2"}; len(paths) != 2 || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("GetDraftPicks() requested %v, want %v", paths, want)
+	}
+
+	if picks := draft.ByTeam("car"); len(picks) != 2 || picks[1].LastName.Default != "Aho" {
+		t.Errorf("ByTeam(car) = %+v, want Hanifin and Aho", picks)
+	}
+	if pick := draft.Overall(1); pick == nil || pick.LastName.Default != "McDavid" {
+		t.Errorf("Overall(1) = %+v, want McDavid", pick)
+	}
+	if pick := draft.Overall(2); pick != nil {
+		t.Errorf("Overall(2) = %+v, want nil", pick)
+	}
+
+	if _, err := client.GetDraftPicks(ctx, 2015, -1); err == nil {
+		t.Error("GetDraftPicks() with a negative round returned no error")
+	}
+}
+
+func TestLastDraftYear(t *testing.T) {
+	tests := map[string]int{"2026-10-17": 2026, "2027-03-01": 2026, "2027-06-30": 2026, "2027-07-01": 2027}
+	for date, want := range tests {
+		now, _ := time.Parse(time.DateOnly, date)
+		if got := lastDraftYear(now); got != want {
+			t.Errorf("lastDraftYear(%s) = %d, want %d", date, got, want)
+		}
+	}
+}
+
+func TestParseDraftRankingCategory(t *testing.T) {
+	category, err := ParseDraftRankingCategory("International-Goalie")
+	if err != nil || category != DraftRankingInternationalGoalies {
+		t.Errorf("ParseDraftRankingCategory() = %v, %v, want %v", category, err, DraftRankingInternationalGoalies)
+	}
+	if _, err := ParseDraftRankingCategory("european-skater"); err == nil {
+		t.Error("ParseDraftRankingCategory() with an unknown key returned no error")
+	}
+}
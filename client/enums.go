@@ -0,0 +1,250 @@
+package nhl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GameState is where a game is in its life, from scheduled to final.
+// Values the API adds later decode as they are, with Known false.
+type GameState string
+
+const (
+	GameStateFuture   GameState = "FUT"   // scheduled
+	GameStatePregame  GameState = "PRE"   // warmups, shortly before the start
+	GameStateLive     GameState = "LIVE"  // in progress
+	GameStateCritical GameState = "CRIT"  // in progress, late in a close game
+	GameStateFinal    GameState = "FINAL" // over, the result not yet official
+	GameStateOff      GameState = "OFF"   // over and official
+)
+
+// ParseGameState parses a game state such as FUT or live, ignoring case
+func ParseGameState(s string) (GameState, error) {
+	state := GameState(strings.ToUpper(strings.TrimSpace(s)))
+	if !state.Known() {
+		return "", fmt.Errorf("invalid game state %q, want FUT, PRE, LIVE, CRIT, FINAL or OFF", s)
+	}
+	return state, nil
+}
+
+// Known reports whether the state is one of the states above
+func (s GameState) Known() bool {
+	switch s {
+	case GameStateFuture, GameStatePregame, GameStateLive, GameStateCritical, GameStateFinal, GameStateOff:
+		return true
+	}
+	return false
+}
+
+// IsScheduled reports whether the game has yet to start
+func (s GameState) IsScheduled() bool {
+	return s == GameStateFuture || s == GameStatePregame
+}
+
+// IsLive reports whether the game is in progress
+func (s GameState) IsLive() bool {
+	return s == GameStateLive || s == GameStateCritical
+}
+
+// IsFinal reports whether the game is over
+func (s GameState) IsFinal() bool {
+	return s == GameStateFinal || s == GameStateOff
+}
+
+// UnmarshalJSON decodes a game state, keeping values it does not know
+func (s *GameState) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalCode(data, "game state")
+	*s = GameState(v)
+	return err
+}
+
+// PeriodType is how a period is played: regulation, overtime or shootout
+type PeriodType string
+
+const (
+	PeriodTypeRegulation PeriodType = "REG"
+	PeriodTypeOvertime   PeriodType = "OT"
+	PeriodTypeShootout   PeriodType = "SO"
+)
+
+// Known reports whether the period type is one of the types above
+func (t PeriodType) Known() bool {
+	switch t {
+	case PeriodTypeRegulation, PeriodTypeOvertime, PeriodTypeShootout:
+		return true
+	}
+	return false
+}
+
+// IsRegulation reports whether the period is one of the regulation periods
+func (t PeriodType) IsRegulation() bool {
+	return t == PeriodTypeRegulation
+}
+
+// IsOvertime reports whether the period is an overtime period
+func (t PeriodType) IsOvertime() bool {
+	return t == PeriodTypeOvertime
+}
+
+// IsShootout reports whether the period is a shootout
+func (t PeriodType) IsShootout() bool {
+	return t == PeriodTypeShootout
+}
+
+// UnmarshalJSON decodes a period type, keeping values it does not know
+func (t *PeriodType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalCode(data, "period type")
+	*t = PeriodType(v)
+	return err
+}
+
+// Position is a player's position code
+type Position string
+
+const (
+	PositionCenter    Position = "C"
+	PositionLeftWing  Position = "L"
+	PositionRightWing Position = "R"
+	PositionDefense   Position = "D"
+	PositionGoalie    Position = "G"
+
+	// PositionForward is not a player's position, but a filter matching
+	// centers and wingers
+	PositionForward Position = "F"
+)
+
+// ParsePosition parses a position code or name, such as D, LW or goalie
+func ParsePosition(s string) (Position, error) {
+	if p := normalizePosition(s); p.Known() || p == PositionForward {
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid position %q, want C, L, R, D, G or F", s)
+}
+
+// normalizePosition maps the names and codes positions appear as to a
+// position code, leaving other values upper-cased
+func normalizePosition(s string) Position {
+	switch code := strings.ToUpper(strings.TrimSpace(s)); code {
+	case "CENTER", "CENTRE":
+		return PositionCenter
+	case "LW", "LEFT WING":
+		return PositionLeftWing
+	case "RW", "RIGHT WING":
+		return PositionRightWing
+	case "DEFENSE", "DEFENCE", "DEFENSEMAN", "DEFENCEMAN":
+		return PositionDefense
+	case "GOALIE", "GOALTENDER":
+		return PositionGoalie
+	case "FORWARD", "FORWARDS":
+		return PositionForward
+	default:
+		return Position(code)
+	}
+}
+
+// Known reports whether the position is a player's position
+func (p Position) Known() bool {
+	switch p {
+	case PositionCenter, PositionLeftWing, PositionRightWing, PositionDefense, PositionGoalie:
+		return true
+	}
+	return false
+}
+
+// IsForward reports whether the position is center or wing
+func (p Position) IsForward() bool {
+	return p == PositionCenter || p == PositionLeftWing || p == PositionRightWing
+}
+
+// IsDefense reports whether the position is defense
+func (p Position) IsDefense() bool {
+	return p == PositionDefense
+}
+
+// IsGoalie reports whether the position is goalie
+func (p Position) IsGoalie() bool {
+	return p == PositionGoalie
+}
+
+// IsSkater reports whether the position is a forward or defense
+func (p Position) IsSkater() bool {
+	return p.IsForward() || p.IsDefense()
+}
+
+// Matches reports whether a player at position passes p as a filter:
+// PositionForward matches any forward, and every other position itself
+func (p Position) Matches(position Position) bool {
+	if p == PositionForward {
+		return position.IsForward()
+	}
+	return p == position
+}
+
+// Name returns the position's name, such as Left Wing
+func (p Position) Name() string {
+	switch p {
+	case PositionCenter:
+		return "Center"
+	case PositionLeftWing:
+		return "Left Wing"
+	case PositionRightWing:
+		return "Right Wing"
+	case PositionDefense:
+		return "Defense"
+	case PositionGoalie:
+		return "Goalie"
+	case PositionForward:
+		return "Forward"
+	}
+	return string(p)
+}
+
+// UnmarshalJSON decodes a position code, mapping names such as LW to their
+// codes and keeping values it does not know
+func (p *Position) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalCode(data, "position")
+	*p = normalizePosition(v)
+	return err
+}
+
+// Known reports whether the game type is one of the types above
+func (t GameType) Known() bool {
+	switch t {
+	case GameTypePreseason, GameTypeRegularSeason, GameTypePlayoffs, GameTypeAllStar:
+		return true
+	}
+	return false
+}
+
+// IsPlayoffs reports whether the game type is playoffs
+func (t GameType) IsPlayoffs() bool {
+	return t == GameTypePlayoffs
+}
+
+// String returns the game type's name, such as Regular Season
+func (t GameType) String() string {
+	switch t {
+	case GameTypePreseason:
+		return "Preseason"
+	case GameTypeRegularSeason:
+		return "Regular Season"
+	case GameTypePlayoffs:
+		return "Playoff"
+	case GameTypeAllStar:
+		return "All-Star"
+	}
+	return "Unknown"
+}
+
+// unmarshalCode decodes a JSON string code, upper-cased, with null as ""
+func unmarshalCode(data []byte, kind string) (string, error) {
+	if string(data) == "null" {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", fmt.Errorf("%s %s is not a string", kind, data)
+	}
+	return strings.ToUpper(s), nil
+}
@@ -0,0 +1,96 @@
+package nhl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumJSON(t *testing.T) {
+	var game struct {
+		GameState  GameState  `json:"gameState"`
+		PeriodType PeriodType `json:"periodType"`
+		Position   Position   `json:"position"`
+		GameType   GameType   `json:"gameType"`
+	}
+	if err := json.Unmarshal([]byte(`{"gameState":"crit","periodType":"OT","position":"LW","gameType":3}`), &game); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if game.GameState != GameStateCritical || game.PeriodType != PeriodTypeOvertime || game.Position != PositionLeftWing || game.GameType != GameTypePlayoffs {
+		t.Errorf("Unmarshal() = %+v", game)
+	}
+
+	out, err := json.Marshal(game)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(out) != `{"gameState":"CRIT","periodType":"OT","position":"L","gameType":3}` {
+		t.Errorf("Marshal() = %s", out)
+	}
+
+	// Values the API adds later are kept rather than failing the response
+	if err := json.Unmarshal([]byte(`{"gameState":"PPD","periodType":null,"position":"W"}`), &game); err != nil {
+		t.Fatalf("Unmarshal(unknown values) error = %v", err)
+	}
+	if game.GameState != "PPD" || game.GameState.Known() || game.PeriodType != "" || game.Position.Known() {
+		t.Errorf("Unmarshal(unknown values) = %+v", game)
+	}
+
+	if err := json.Unmarshal([]byte(`{"gameState":7}`), &game); err == nil {
+		t.Error("Unmarshal(numeric game state) should fail")
+	}
+}
+
+func TestGameStatePredicates(t *testing.T) {
+	tests := []struct {
+		state                      GameState
+		scheduled, live, finalized bool
+	}{
+		{GameStateFuture, true, false, false},
+		{GameStatePregame, true, false, false},
+		{GameStateLive, false, true, false},
+		{GameStateCritical, false, true, false},
+		{GameStateFinal, false, false, true},
+		{GameStateOff, false, false, true},
+		{"PPD", false, false, false},
+	}
+	for _, tt := range tests {
+		if tt.state.IsScheduled() != tt.scheduled || tt.state.IsLive() != tt.live || tt.state.IsFinal() != tt.finalized {
+			t.Errorf("%s: IsScheduled, IsLive, IsFinal = %v, %v, %v, want %v, %v, %v", tt.state,
+				tt.state.IsScheduled(), tt.state.IsLive(), tt.state.IsFinal(), tt.scheduled, tt.live, tt.finalized)
+		}
+	}
+
+	if state, err := ParseGameState(" live "); err != nil || state != GameStateLive {
+		t.Errorf("ParseGameState(live) = %q, %v", state, err)
+	}
+	if _, err := ParseGameState("POSTPONED"); err == nil {
+		t.Error("ParseGameState(POSTPONED) should fail")
+	}
+}
+
+func TestPosition(t *testing.T) {
+	for input, want := range map[string]Position{"c": PositionCenter, "RW": PositionRightWing, "Defenseman": PositionDefense, "goalie": PositionGoalie, "F": PositionForward} {
+		if got, err := ParsePosition(input); err != nil || got != want {
+			t.Errorf("ParsePosition(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParsePosition("X"); err == nil {
+		t.Error("ParsePosition(X) should fail")
+	}
+
+	if !PositionForward.Matches(PositionLeftWing) || PositionForward.Matches(PositionDefense) || !PositionGoalie.Matches(PositionGoalie) {
+		t.Error("Matches() should match forwards to F and other positions to themselves")
+	}
+	if !PositionDefense.IsSkater() || PositionGoalie.IsSkater() || PositionRightWing.Name() != "Right Wing" {
+		t.Error("IsSkater() or Name() is wrong")
+	}
+}
+
+func TestPeriodTypeAndGameType(t *testing.T) {
+	if !PeriodTypeOvertime.IsOvertime() || PeriodTypeShootout.IsOvertime() || !PeriodTypeShootout.IsShootout() || !PeriodTypeRegulation.IsRegulation() {
+		t.Error("period type predicates are wrong")
+	}
+	if GameTypePlayoffs.String() != "Playoff" || !GameTypePlayoffs.IsPlayoffs() || GameType(9).Known() || GameType(9).String() != "Unknown" {
+		t.Error("game type String(), IsPlayoffs() or Known() is wrong")
+	}
+}
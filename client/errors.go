@@ -0,0 +1,114 @@
+package nhl
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Sentinel errors for matching with errors.Is
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrRateLimited = errors.New("rate limited")
+	ErrUnavailable = errors.New("service unavailable")
+	ErrDecode      = errors.New("failed to decode response")
+)
+
+// maxBodyExcerpt is the longest response body kept in an APIError
+const maxBodyExcerpt = 256
+
+// APIError is returned when the NHL API responds with a non-200 status.
+// It matches ErrNotFound for 404, ErrRateLimited for 429 and
+// ErrUnavailable for 5xx responses.
+type APIError struct {
+	Endpoint   string // URL path with IDs replaced, e.g. "gamecenter/{id}/boxscore"
+	URL        string
+	StatusCode int
+	Body       string // Start of the response body
+}
+
+// Error implements error
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s: unexpected status code: %d", e.Endpoint, e.StatusCode)
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the status sentinels
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnavailable:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// newAPIError builds an APIError for a response, keeping a short excerpt of body
+func newAPIError(url string, statusCode int, body []byte) *APIError {
+	return &APIError{
+		Endpoint:   endpointName(url),
+		URL:        url,
+		StatusCode: statusCode,
+		Body:       bodyExcerpt(body),
+	}
+}
+
+// decodeError wraps a JSON decoding failure so it matches ErrDecode
+func decodeError(url string, err error) error {
+	return fmt.Errorf("%w from %s: %w", ErrDecode, endpointName(url), err)
+}
+
+// bodyExcerpt returns the start of body on a single line
+func bodyExcerpt(body []byte) string {
+	excerpt := strings.Join(strings.Fields(string(body)), " ")
+	if len(excerpt) <= maxBodyExcerpt {
+		return excerpt
+	}
+	cut := maxBodyExcerpt
+	for cut > 0 && !utf8.RuneStart(excerpt[cut]) {
+		cut--
+	}
+	return excerpt[:cut] + "..."
+}
+
+var (
+	versionSegment = regexp.MustCompile(`^v\d+$`)
+	idSegment      = regexp.MustCompile(`^\d+$`)
+	dateSegment    = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	teamSegment    = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// endpointName returns the API path of url with the version prefix dropped
+// and IDs, dates and team codes replaced by placeholders
+func endpointName(url string) string {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return url
+	}
+
+	var segments []string
+	for i, segment := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		switch {
+		case i == 0 && versionSegment.MatchString(segment):
+			continue
+		case idSegment.MatchString(segment):
+			segment = "{id}"
+		case dateSegment.MatchString(segment):
+			segment = "{date}"
+		case teamSegment.MatchString(segment):
+			segment = "{team}"
+		}
+		segments = append(segments, segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,129 @@
+package nhl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClientGetErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantIs     error
+		wantStatus int
+	}{
+		{
+			name:       "Not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"message":"Game not found"}`,
+			wantIs:     ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Rate limited",
+			statusCode: http.StatusTooManyRequests,
+			wantIs:     ErrRateLimited,
+			wantStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:       "Server error",
+			statusCode: http.StatusServiceUnavailable,
+			wantIs:     ErrUnavailable,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "Invalid JSON",
+			statusCode: http.StatusOK,
+			body:       `{"gameState":`,
+			wantIs:     ErrDecode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(
+				WithRetryPolicy(NoRetry),
+				WithHTTPClient(&mockHTTPClient{
+					DoFunc: func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: tt.statusCode,
+							Header:     http.Header{},
+							Body:       io.NopCloser(bytes.NewReader([]byte(tt.body))),
+						}, nil
+					},
+				}),
+			)
+
+			_, err := client.GetGameBoxscore(context.Background(), 2023020204)
+			if !errors.Is(err, tt.wantIs) {
+				t.Fatalf("GetGameBoxscore() error = %v, want %v", err, tt.wantIs)
+			}
+
+			var apiErr *APIError
+			if tt.wantStatus == 0 {
+				if errors.As(err, &apiErr) {
+					t.Errorf("GetGameBoxscore() error = %v, want no APIError", err)
+				}
+				return
+			}
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("GetGameBoxscore() error = %v, want APIError", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.wantStatus)
+			}
+			if apiErr.Endpoint != "gamecenter/{id}/boxscore" {
+				t.Errorf("Endpoint = %s, want gamecenter/{id}/boxscore", apiErr.Endpoint)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("Body = %s, want %s", apiErr.Body, tt.body)
+			}
+		})
+	}
+}
+
+func TestTeamNotFound(t *testing.T) {
+	client := NewClient()
+
+	_, err := client.GetTeamByIdentifier(context.Background(), "XYZ")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTeamByIdentifier() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEndpointName(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{BaseURLWeb + "/gamecenter/2024020750/play-by-play", "gamecenter/{id}/play-by-play"},
+		{BaseURLWeb + "/score/2024-02-09?sort=desc", "score/{date}"},
+		{BaseURLWeb + "/club-schedule-season/NYR/20232024", "club-schedule-season/{team}/{id}"},
+		{BaseURLWeb + "/roster/TOR/current", "roster/{team}/current"},
+		{BaseURLForge + "/content/en-us/videos?tags.slug=gameid-2024020750", "content/en-us/videos"},
+	}
+
+	for _, tt := range tests {
+		if got := endpointName(tt.url); got != tt.want {
+			t.Errorf("endpointName(%s) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestBodyExcerpt(t *testing.T) {
+	long := strings.Repeat("é", maxBodyExcerpt)
+	got := bodyExcerpt([]byte(long))
+	if !strings.HasSuffix(got, "...") || len(got) > maxBodyExcerpt+3 {
+		t.Errorf("bodyExcerpt() = %d bytes, want at most %d ending in ...", len(got), maxBodyExcerpt+3)
+	}
+
+	if got := bodyExcerpt([]byte("line one\n  line two")); got != "line one line two" {
+		t.Errorf("bodyExcerpt() = %q, want %q", got, "line one line two")
+	}
+}
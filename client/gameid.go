@@ -0,0 +1,203 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GameID identifies a game. Its ten digits are the year its season
+// started, the game type and the game number, so 2023020750 is regular
+// season game 750 of 2023-2024. Playoff game numbers are 0RSG: the round,
+// the series within the round and the game within the series.
+type GameID int
+
+// Game ID ranges accepted by Validate
+const (
+	firstGameIDYear = 1917
+	lastGameIDYear  = 2999
+	maxGameNumber   = 9999
+)
+
+// NewGameID builds the ID of game number of gameType in the season
+// starting in startYear, such as NewGameID(2023, GameTypeRegularSeason,
+// 750) for 2023020750
+func NewGameID(startYear int, gameType GameType, number int) (GameID, error) {
+	if gameType < 0 || gameType > 99 || number < 0 || number > maxGameNumber {
+		return 0, fmt.Errorf("invalid game ID parts %d, %d, %d", startYear, gameType, number)
+	}
+	id := GameID(startYear*1000000 + int(gameType)*10000 + number)
+	if err := id.Validate(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// StartYear returns the year the game's season started, such as 2023
+func (id GameID) StartYear() int {
+	return int(id) / 1000000
+}
+
+// Season returns the game's season ID, such as 20232024
+func (id GameID) Season() int {
+	year := id.StartYear()
+	return year*10000 + year + 1
+}
+
+// GameType returns the game's type, such as GameTypeRegularSeason
+func (id GameID) GameType() GameType {
+	return GameType(int(id) / 10000 % 100)
+}
+
+// Number returns the game's number within its season and type
+func (id GameID) Number() int {
+	return int(id) % 10000
+}
+
+// PlayoffRound returns a playoff game's round, 1 to 4, or 0 for other games
+func (id GameID) PlayoffRound() int {
+	if !id.GameType().IsPlayoffs() {
+		return 0
+	}
+	return id.Number() / 100 % 10
+}
+
+// PlayoffSeries returns which of its round's series a playoff game is in,
+// counting from 1, or 0 for other games
+func (id GameID) PlayoffSeries() int {
+	if !id.GameType().IsPlayoffs() {
+		return 0
+	}
+	return id.Number() / 10 % 10
+}
+
+// PlayoffGame returns a playoff game's number within its series, 1 to 7,
+// or 0 for other games
+func (id GameID) PlayoffGame() int {
+	if !id.GameType().IsPlayoffs() {
+		return 0
+	}
+	return id.Number() % 10
+}
+
+// Validate reports whether the ID is a possible game: a season from 1917
+// on, a known game type and a game number from 1, which for playoff games
+// must be a real round, series and game
+func (id GameID) Validate() error {
+	if year := id.StartYear(); year < firstGameIDYear || year > lastGameIDYear {
+		return fmt.Errorf("invalid game ID %d: season %d is out of range", id, year)
+	}
+	if !id.GameType().Known() {
+		return fmt.Errorf("invalid game ID %d: unknown game type %d", id, id.GameType())
+	}
+	if id.Number() < 1 {
+		return fmt.Errorf("invalid game ID %d: game number must be at least 1", id)
+	}
+	if id.GameType().IsPlayoffs() {
+		round, series, game := id.PlayoffRound(), id.PlayoffSeries(), id.PlayoffGame()
+		// Each round has half the series of the one before: 8, 4, 2 then 1
+		if id.Number() >= 1000 || round < 1 || round > 4 || series < 1 || series > 16>>round || game < 1 || game > 7 {
+			return fmt.Errorf("invalid game ID %d: no game %d of series %d in round %d", id, game, series, round)
+		}
+	}
+	return nil
+}
+
+// String returns the ID's ten digits
+func (id GameID) String() string {
+	return strconv.Itoa(int(id))
+}
+
+// UnmarshalJSON accepts an ID as a JSON number or string
+func (id *GameID) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*id = GameID(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("game ID %s is neither a number nor a string", data)
+	}
+	if s == "" {
+		*id = 0
+		return nil
+	}
+	parsed, err := ParseGameID(s)
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+var (
+	dashedGameID  = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,4})$`)
+	matchupGameID = regexp.MustCompile(`(?i)^([a-z]{3})\s*(@|at|vs\.?)\s*([a-z]{3})\s+(\d{4}-\d{2}-\d{2})$`)
+	teamGameID    = regexp.MustCompile(`(?i)^([a-z]{3})\s+(\d{4}-\d{2}-\d{2})$`)
+)
+
+// ParseGameID parses and validates a game ID written as its digits, such
+// as 2023020750, or with its parts separated, such as 2023-02-0750. Use
+// Client.ResolveGameID to also find games by team and date.
+func ParseGameID(s string) (GameID, error) {
+	s = strings.TrimSpace(s)
+	if m := dashedGameID.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		gameType, _ := strconv.Atoi(m[2])
+		number, _ := strconv.Atoi(m[3])
+		return NewGameID(year, GameType(gameType), number)
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid game ID %q, want a form such as 2023020750 or 2023-02-0750", s)
+	}
+	id := GameID(n)
+	if err := id.Validate(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// ResolveGameID finds a game from any of the forms ParseGameID accepts, or
+// from its teams and date: "NYR@CHI 2024-02-09" or "NYR at CHI 2024-02-09"
+// for the away team at the home team, "CHI vs NYR 2024-02-09" for the home
+// team against the away team, or "NYR 2024-02-09" for a team's game that
+// day. Teams and dates are looked up in the schedule.
+func (c *Client) ResolveGameID(ctx context.Context, s string) (GameID, error) {
+	s = strings.TrimSpace(s)
+	var away, home, team, date string
+	if m := matchupGameID.FindStringSubmatch(s); m != nil {
+		away, home, date = m[1], m[3], m[4]
+		if strings.HasPrefix(strings.ToLower(m[2]), "vs") {
+			away, home = home, away
+		}
+	} else if m := teamGameID.FindStringSubmatch(s); m != nil {
+		team, date = m[1], m[2]
+	} else {
+		return ParseGameID(s)
+	}
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return 0, fmt.Errorf("invalid date %q in game %q: %w", date, s, err)
+	}
+
+	schedule, err := c.GetScheduleByDate(ctx, date, SortByDateAsc)
+	if err != nil {
+		return 0, err
+	}
+	for _, game := range schedule.Games {
+		awayAbbrev, homeAbbrev := game.AwayTeam.Abbrev, game.HomeTeam.Abbrev
+		if team != "" && (strings.EqualFold(team, awayAbbrev) || strings.EqualFold(team, homeAbbrev)) {
+			return game.ID, nil
+		}
+		if team == "" && strings.EqualFold(away, awayAbbrev) && strings.EqualFold(home, homeAbbrev) {
+			return game.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("no game %q found on %s", s, date)
+}
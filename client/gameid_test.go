@@ -0,0 +1,132 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGameIDParts(t *testing.T) {
+	id := GameID(2023030243)
+	if id.StartYear() != 2023 || id.Season() != 20232024 || id.GameType() != GameTypePlayoffs || id.Number() != 243 {
+		t.Errorf("parts of %d = %d, %d, %d, %d", id, id.StartYear(), id.Season(), id.GameType(), id.Number())
+	}
+	if id.PlayoffRound() != 2 || id.PlayoffSeries() != 4 || id.PlayoffGame() != 3 {
+		t.Errorf("playoff parts of %d = round %d, series %d, game %d", id, id.PlayoffRound(), id.PlayoffSeries(), id.PlayoffGame())
+	}
+	if GameID(2023020750).PlayoffRound() != 0 {
+		t.Error("PlayoffRound() of a regular season game should be 0")
+	}
+
+	built, err := NewGameID(2023, GameTypeRegularSeason, 750)
+	if err != nil || built != 2023020750 || built.String() != "2023020750" {
+		t.Errorf("NewGameID() = %v, %v, want 2023020750", built, err)
+	}
+	if _, err := NewGameID(2023, GameTypeRegularSeason, 10000); err == nil {
+		t.Error("NewGameID() with a five digit game number should fail")
+	}
+}
+
+func TestGameIDValidate(t *testing.T) {
+	tests := []struct {
+		id    GameID
+		valid bool
+	}{
+		{2023020750, true},
+		{2023010001, true},
+		{2023030111, true},
+		{2023030411, true},
+		{2023020000, false}, // no game number
+		{2023050750, false}, // unknown game type
+		{1850020750, false}, // before the NHL
+		{2023030511, false}, // no fifth round
+		{2023030151, true},
+		{2023030191, false}, // eight series in the first round
+		{2023030241, true},
+		{2023030251, false}, // four in the second
+		{2023030118, false}, // no game 8
+		{2023031111, false},
+		{750, false},
+	}
+	for _, tt := range tests {
+		if err := tt.id.Validate(); (err == nil) != tt.valid {
+			t.Errorf("Validate(%d) error = %v, want valid %v", tt.id, err, tt.valid)
+		}
+	}
+}
+
+func TestParseGameID(t *testing.T) {
+	for input, want := range map[string]GameID{
+		"2023020750":    2023020750,
+		" 2023-02-0750": 2023020750,
+		"2023-2-750":    2023020750,
+		"2023-03-0111":  2023030111,
+	} {
+		if got, err := ParseGameID(input); err != nil || got != want {
+			t.Errorf("ParseGameID(%q) = %d, %v, want %d", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "abc", "2023-05-0750", "20230207500", "NYR@CHI 2024-02-09"} {
+		if _, err := ParseGameID(input); err == nil {
+			t.Errorf("ParseGameID(%q) should fail", input)
+		}
+	}
+
+	var game struct {
+		ID GameID `json:"id"`
+	}
+	for _, data := range []string{`{"id":2023020750}`, `{"id":"2023020750"}`, `{"id":"2023-02-0750"}`} {
+		if err := json.Unmarshal([]byte(data), &game); err != nil || game.ID != 2023020750 {
+			t.Errorf("Unmarshal(%s) = %d, %v", data, game.ID, err)
+		}
+	}
+	if out, _ := json.Marshal(game); string(out) != `{"id":2023020750}` {
+		t.Errorf("Marshal() = %s, want a number", out)
+	}
+}
+
+func TestResolveGameID(t *testing.T) {
+	var requests int
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			if req.URL.Path != "/v1/score/2024-02-09" {
+				t.Errorf("ResolveGameID() requested %s", req.URL.Path)
+			}
+			return mockResponse(http.StatusOK, map[string]any{
+				"games": []map[string]any{
+					{"id": 2023020751, "awayTeam": map[string]any{"abbrev": "TOR"}, "homeTeam": map[string]any{"abbrev": "BOS"}},
+					{"id": 2023020750, "awayTeam": map[string]any{"abbrev": "NYR"}, "homeTeam": map[string]any{"abbrev": "CHI"}},
+				},
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry), WithCache(nil))
+	ctx := context.Background()
+
+	tests := []struct {
+		input string
+		want  GameID
+	}{
+		{"NYR@CHI 2024-02-09", 2023020750},
+		{"nyr at chi 2024-02-09", 2023020750},
+		{"CHI vs NYR 2024-02-09", 2023020750},
+		{"BOS 2024-02-09", 2023020751},
+		{"2023-02-0750", 2023020750},
+	}
+	for _, tt := range tests {
+		if got, err := client.ResolveGameID(ctx, tt.input); err != nil || got != tt.want {
+			t.Errorf("ResolveGameID(%q) = %d, %v, want %d", tt.input, got, err, tt.want)
+		}
+	}
+	if requests != 4 {
+		t.Errorf("ResolveGameID() made %d requests, want one per team lookup", requests)
+	}
+
+	for _, input := range []string{"CHI@NYR 2024-02-09", "DAL 2024-02-09", "NYR@CHI 2024-02-30"} {
+		if _, err := client.ResolveGameID(ctx, input); err == nil {
+			t.Errorf("ResolveGameID(%q) should fail", input)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-nhl/internal/formatters"
+	"time"
+)
+
+// PlayerGameLog is a player's game-by-game stats for one season and game
+// type. Skaters fill Skaters and goalies fill Goalies, most recent game first.
+type PlayerGameLog struct {
+	PlayerID int                 `json:"playerId"`
+	SeasonID int                 `json:"seasonId"`
+	GameType GameType            `json:"gameTypeId"`
+	Seasons  []PlayerStatsSeason `json:"playerStatsSeasons"`
+	Goalie   bool                `json:"goalie"`
+	Skaters  []SkaterGameLog     `json:"skaterGames,omitempty"`
+	Goalies  []GoalieGameLog     `json:"goalieGames,omitempty"`
+}
+
+// PlayerStatsSeason is a season a player has game logs for, with the game
+// types played in it
+type PlayerStatsSeason struct {
+	Season    int   `json:"season"`
+	GameTypes []int `json:"gameTypes"`
+}
+
+// SkaterGameLog is a skater's line for a single game
+type SkaterGameLog struct {
+	GameID             GameID        `json:"gameId"`
+	GameDate           string        `json:"gameDate"`
+	TeamAbbrev         string        `json:"teamAbbrev"`
+	HomeRoadFlag       string        `json:"homeRoadFlag"` // H or R
+	OpponentAbbrev     string        `json:"opponentAbbrev"`
+	OpponentCommonName LanguageNames `json:"opponentCommonName"`
+	Goals              int           `json:"goals"`
+	Assists            int           `json:"assists"`
+	Points             int           `json:"points"`
+	PlusMinus          int           `json:"plusMinus"`
+	PowerPlayGoals     int           `json:"powerPlayGoals"`
+	PowerPlayPoints    int           `json:"powerPlayPoints"`
+	ShorthandedGoals   int           `json:"shorthandedGoals"`
+	ShorthandedPoints  int           `json:"shorthandedPoints"`
+	GameWinningGoals   int           `json:"gameWinningGoals"`
+	OTGoals            int           `json:"otGoals"`
+	Shots              int           `json:"shots"`
+	Shifts             int           `json:"shifts"`
+	PenaltyMinutes     int           `json:"pim"`
+	TOI                string        `json:"toi"`
+}
+
+// GoalieGameLog is a goalie's line for a single game
+type GoalieGameLog struct {
+	GameID             GameID        `json:"gameId"`
+	GameDate           string        `json:"gameDate"`
+	TeamAbbrev         string        `json:"teamAbbrev"`
+	HomeRoadFlag       string        `json:"homeRoadFlag"` // H or R
+	OpponentAbbrev     string        `json:"opponentAbbrev"`
+	OpponentCommonName LanguageNames `json:"opponentCommonName"`
+	GamesStarted       int           `json:"gamesStarted"`
+	Decision           string        `json:"decision,omitempty"` // W, L or O
+	ShotsAgainst       int           `json:"shotsAgainst"`
+	GoalsAgainst       int           `json:"goalsAgainst"`
+	SavePctg           float64       `json:"savePctg"`
+	Shutouts           int           `json:"shutouts"`
+	Goals              int           `json:"goals"`
+	Assists            int           `json:"assists"`
+	PenaltyMinutes     int           `json:"pim"`
+	TOI                string        `json:"toi"`
+}
+
+// SkaterGameLogTotals sums a skater's game log
+type SkaterGameLogTotals struct {
+	GamesPlayed       int     `json:"gamesPlayed"`
+	Goals             int     `json:"goals"`
+	Assists           int     `json:"assists"`
+	Points            int     `json:"points"`
+	PlusMinus         int     `json:"plusMinus"`
+	PowerPlayGoals    int     `json:"powerPlayGoals"`
+	PowerPlayPoints   int     `json:"powerPlayPoints"`
+	ShorthandedGoals  int     `json:"shorthandedGoals"`
+	ShorthandedPoints int     `json:"shorthandedPoints"`
+	GameWinningGoals  int     `json:"gameWinningGoals"`
+	OTGoals           int     `json:"otGoals"`
+	Shots             int     `json:"shots"`
+	PenaltyMinutes    int     `json:"pim"`
+	ShootingPctg      float64 `json:"shootingPctg"`
+	AvgTOI            string  `json:"avgToi"`
+}
+
+// GoalieGameLogTotals sums a goalie's game log
+type GoalieGameLogTotals struct {
+	GamesPlayed         int     `json:"gamesPlayed"`
+	GamesStarted        int     `json:"gamesStarted"`
+	Wins                int     `json:"wins"`
+	Losses              int     `json:"losses"`
+	OTLosses            int     `json:"otLosses"`
+	ShotsAgainst        int     `json:"shotsAgainst"`
+	GoalsAgainst        int     `json:"goalsAgainst"`
+	SavePctg            float64 `json:"savePctg"`
+	GoalsAgainstAverage float64 `json:"goalsAgainstAverage"`
+	Shutouts            int     `json:"shutouts"`
+	TOI                 string  `json:"toi"`
+}
+
+// GetPlayerGameLog returns a player's game log for a season and game type.
+// If seasonID is 0 the current season is used, and if gameType is 0 the
+// regular season.
+func (c *Client) GetPlayerGameLog(ctx context.Context, playerID, seasonID int, gameType GameType) (*PlayerGameLog, error) {
+	if playerID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	if seasonID == 0 {
+		seasonID = formatters.GetCurrentSeasonID()
+	}
+	if gameType == 0 {
+		gameType = GameTypeRegularSeason
+	}
+
+	url := fmt.Sprintf("%s/player/%d/game-log/%d/%d", c.baseURL, playerID, seasonID, gameType)
+	var response struct {
+		PlayerStatsSeasons []PlayerStatsSeason `json:"playerStatsSeasons"`
+		GameLog            []json.RawMessage   `json:"gameLog"`
+	}
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get player game log: %w", err)
+	}
+
+	log := &PlayerGameLog{
+		PlayerID: playerID,
+		SeasonID: seasonID,
+		GameType: gameType,
+		Seasons:  response.PlayerStatsSeasons,
+	}
+	for _, raw := range response.GameLog {
+		// Only goalie rows carry games started
+		var probe struct {
+			GamesStarted *int `json:"gamesStarted"`
+		}
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, decodeError(url, err)
+		}
+
+		if probe.GamesStarted != nil {
+			var game GoalieGameLog
+			if err := json.Unmarshal(raw, &game); err != nil {
+				return nil, decodeError(url, err)
+			}
+			log.Goalie = true
+			log.Goalies = append(log.Goalies, game)
+			continue
+		}
+
+		var game SkaterGameLog
+		if err := json.Unmarshal(raw, &game); err != nil {
+			return nil, decodeError(url, err)
+		}
+		log.Skaters = append(log.Skaters, game)
+	}
+
+	return log, nil
+}
+
+// Between returns a copy of the log holding only games played from from to
+// to, inclusive. Dates use the YYYY-MM-DD format; an empty date leaves
+// that end of the range open.
+func (l *PlayerGameLog) Between(from, to string) (*PlayerGameLog, error) {
+	for _, date := range []string{from, to} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, fmt.Errorf("invalid date %q, want YYYY-MM-DD", date)
+		}
+	}
+	if from != "" && to != "" && from > to {
+		return nil, fmt.Errorf("start date %s is after end date %s", from, to)
+	}
+
+	inRange := func(date string) bool {
+		return (from == "" || date >= from) && (to == "" || date <= to)
+	}
+
+	filtered := *l
+	filtered.Skaters = nil
+	filtered.Goalies = nil
+	for _, game := range l.Skaters {
+		if inRange(game.GameDate) {
+			filtered.Skaters = append(filtered.Skaters, game)
+		}
+	}
+	for _, game := range l.Goalies {
+		if inRange(game.GameDate) {
+			filtered.Goalies = append(filtered.Goalies, game)
+		}
+	}
+	return &filtered, nil
+}
+
+// SkaterTotals sums the skater games in the log
+func (l *PlayerGameLog) SkaterTotals() SkaterGameLogTotals {
+	var totals SkaterGameLogTotals
+	toi := 0
+	for _, game := range l.Skaters {
+		totals.GamesPlayed++
+		totals.Goals += game.Goals
+		totals.Assists += game.Assists
+		totals.Points += game.Points
+		totals.PlusMinus += game.PlusMinus
+		totals.PowerPlayGoals += game.PowerPlayGoals
+		totals.PowerPlayPoints += game.PowerPlayPoints
+		totals.ShorthandedGoals += game.ShorthandedGoals
+		totals.ShorthandedPoints += game.ShorthandedPoints
+		totals.GameWinningGoals += game.GameWinningGoals
+		totals.OTGoals += game.OTGoals
+		totals.Shots += game.Shots
+		totals.PenaltyMinutes += game.PenaltyMinutes
+		if seconds, err := clockSeconds(game.TOI); err == nil {
+			toi += seconds
+		}
+	}
+	if totals.Shots > 0 {
+		totals.ShootingPctg = float64(totals.Goals) / float64(totals.Shots)
+	}
+	if totals.GamesPlayed > 0 {
+		totals.AvgTOI = formatters.FormatTimeOnIce(toi / totals.GamesPlayed)
+	}
+	return totals
+}
+
+// GoalieTotals sums the goalie games in the log
+func (l *PlayerGameLog) GoalieTotals() GoalieGameLogTotals {
+	var totals GoalieGameLogTotals
+	toi := 0
+	for _, game := range l.Goalies {
+		totals.GamesPlayed++
+		totals.GamesStarted += game.GamesStarted
+		switch game.Decision {
+		case "W":
+			totals.Wins++
+		case "L":
+			totals.Losses++
+		case "O":
+			totals.OTLosses++
+		}
+		totals.ShotsAgainst += game.ShotsAgainst
+		totals.GoalsAgainst += game.GoalsAgainst
+		totals.Shutouts += game.Shutouts
+		if seconds, err := clockSeconds(game.TOI); err == nil {
+			toi += seconds
+		}
+	}
+	if totals.ShotsAgainst > 0 {
+		totals.SavePctg = float64(totals.ShotsAgainst-totals.GoalsAgainst) / float64(totals.ShotsAgainst)
+	}
+	if toi > 0 {
+		totals.GoalsAgainstAverage = float64(totals.GoalsAgainst) * 3600 / float64(toi)
+	}
+	totals.TOI = formatters.FormatTimeOnIce(toi)
+	return totals
+}
@@ -0,0 +1,88 @@
+package nhl
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetPlayerGameLog(t *testing.T) {
+	var requested string
+	client := NewClient(WithHTTPClient(&mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requested = req.URL.Path
+			if strings.Contains(req.URL.Path, "/8478048/") {
+				return mockResponse(http.StatusOK, map[string]any{
+					"gameLog": []map[string]any{
+						{"gameId": 2024020750, "gameDate": "2024-02-09", "gamesStarted": 1, "decision": "W", "shotsAgainst": 30, "goalsAgainst": 1, "toi": "60:00"},
+						{"gameId": 2024020705, "gameDate": "2024-02-04", "gamesStarted": 1, "decision": "O", "shotsAgainst": 30, "goalsAgainst": 3, "toi": "64:30"},
+						{"gameId": 2024020660, "gameDate": "2024-01-29", "gamesStarted": 0, "shotsAgainst": 10, "goalsAgainst": 2, "toi": "20:00"},
+					},
+				})
+			}
+			return mockResponse(http.StatusOK, map[string]any{
+				"playerStatsSeasons": []map[string]any{{"season": 20232024, "gameTypes": []int{2, 3}}},
+				"gameLog": []map[string]any{
+					{"gameId": 2024020749, "gameDate": "2024-02-09", "goals": 0, "assists": 1, "points": 1, "shots": 3, "toi": "23:40"},
+					{"gameId": 2024020731, "gameDate": "2024-02-07", "goals": 1, "assists": 1, "points": 2, "shots": 4, "toi": "25:00"},
+					{"gameId": 2024020712, "gameDate": "2024-02-05", "goals": 1, "assists": 0, "points": 1, "shots": 3, "toi": "21:20"},
+				},
+			})
+		},
+	}))
+	ctx := context.Background()
+
+	skater, err := client.GetPlayerGameLog(ctx, 8480036, 20232024, GameTypePlayoffs)
+	if err != nil {
+		t.Fatalf("GetPlayerGameLog() error = %v", err)
+	}
+	if requested != "/v1/player/8480036/game-log/20232024/3" {
+		t.Errorf("requested %s", requested)
+	}
+	if skater.Goalie || len(skater.Skaters) != 3 || len(skater.Seasons) != 1 {
+		t.Fatalf("GetPlayerGameLog() = %+v, want 3 skater games", skater)
+	}
+
+	totals := skater.SkaterTotals()
+	if totals.GamesPlayed != 3 || totals.Goals != 2 || totals.Points != 4 || totals.AvgTOI != "23:20" {
+		t.Errorf("SkaterTotals() = %+v, want 3 GP, 2 G, 4 P, 23:20 TOI", totals)
+	}
+	if math.Abs(totals.ShootingPctg-0.2) > 1e-9 {
+		t.Errorf("SkaterTotals() shooting = %v, want 0.2", totals.ShootingPctg)
+	}
+
+	recent, err := skater.Between("2024-02-06", "")
+	if err != nil {
+		t.Fatalf("Between() error = %v", err)
+	}
+	if len(recent.Skaters) != 2 || len(skater.Skaters) != 3 {
+		t.Errorf("Between() kept %d games, original %d, want 2 and 3", len(recent.Skaters), len(skater.Skaters))
+	}
+	if _, err := skater.Between("2024-02-10", "2024-02-01"); err == nil {
+		t.Error("Between() with reversed dates should return error")
+	}
+	if _, err := skater.Between("Feb 1", ""); err == nil {
+		t.Error("Between() with an invalid date should return error")
+	}
+
+	goalie, err := client.GetPlayerGameLog(ctx, 8478048, 20232024, 0)
+	if err != nil {
+		t.Fatalf("GetPlayerGameLog() error = %v", err)
+	}
+	if !goalie.Goalie || len(goalie.Goalies) != 3 || goalie.GameType != GameTypeRegularSeason {
+		t.Fatalf("GetPlayerGameLog() = %+v, want 3 regular season goalie games", goalie)
+	}
+	gt := goalie.GoalieTotals()
+	if gt.GamesStarted != 2 || gt.Wins != 1 || gt.OTLosses != 1 || gt.ShotsAgainst != 70 || gt.TOI != "144:30" {
+		t.Errorf("GoalieTotals() = %+v", gt)
+	}
+	if math.Abs(gt.SavePctg-64.0/70) > 1e-9 || math.Abs(gt.GoalsAgainstAverage-6*3600/8670.0) > 1e-9 {
+		t.Errorf("GoalieTotals() = %.3f SV%%, %.2f GAA", gt.SavePctg, gt.GoalsAgainstAverage)
+	}
+
+	if _, err := client.GetPlayerGameLog(ctx, 0, 0, 0); err == nil {
+		t.Error("GetPlayerGameLog() with invalid player ID should return error")
+	}
+}
@@ -1,61 +1,59 @@
 package nhl
 
 import (
+	"context"
 	"fmt"
 )
 
 // GetGameDetails returns detailed information about a specific game
-func (c *Client) GetGameDetails(gameID int) (*GameDetails, error) {
+func (c *Client) GetGameDetails(ctx context.Context, gameID GameID) (*GameDetails, error) {
 	url := fmt.Sprintf("%s/gamecenter/%d/landing", c.baseURL, gameID)
 	var response GameDetails
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game details: %v", err)
+		return nil, fmt.Errorf("failed to get game details: %w", err)
 	}
 	return &response, nil
 }
 
 // GetGameBoxscore returns the boxscore for a specific game
-func (c *Client) GetGameBoxscore(gameID int) (*BoxscoreResponse, error) {
+func (c *Client) GetGameBoxscore(ctx context.Context, gameID GameID) (*BoxscoreResponse, error) {
 	url := fmt.Sprintf("%s/gamecenter/%d/boxscore", c.baseURL, gameID)
 	var response BoxscoreResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game boxscore: %v", err)
+		return nil, fmt.Errorf("failed to get game boxscore: %w", err)
 	}
 	return &response, nil
 }
 
 // GetGamePlayByPlay returns the play-by-play data for a specific game
-func (c *Client) GetGamePlayByPlay(gameID int) (*PlayByPlayResponse, error) {
+func (c *Client) GetGamePlayByPlay(ctx context.Context, gameID GameID) (*PlayByPlayResponse, error) {
 	url := fmt.Sprintf("%s/gamecenter/%d/play-by-play", c.baseURL, gameID)
 	var response PlayByPlayResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game play-by-play: %v", err)
+		return nil, fmt.Errorf("failed to get game play-by-play: %w", err)
 	}
 	return &response, nil
 }
 
 // GetGameStory returns the game story/narrative for a specific game
-func (c *Client) GetGameStory(gameID int) (*GameStoryResponse, error) {
-	fmt.Println(c.baseURL)
-	fmt.Println(gameID)
+func (c *Client) GetGameStory(ctx context.Context, gameID GameID) (*GameStoryResponse, error) {
 	url := fmt.Sprintf("%s/wsc/game-story/%d", c.baseURL, gameID)
 	var response GameStoryResponse
-	err := c.get(url, &response)
-	fmt.Println(response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game story: %v", err)
+		return nil, fmt.Errorf("failed to get game story: %w", err)
 	}
 	return &response, nil
 }
 
 // GetLiveGameUpdates returns the current scoreboard with live game information
-func (c *Client) GetLiveGameUpdates() (*ScoreboardResponse, error) {
+func (c *Client) GetLiveGameUpdates(ctx context.Context) (*ScoreboardResponse, error) {
 	url := fmt.Sprintf("%s/scoreboard/now", c.baseURL)
 	var response ScoreboardResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get live game updates: %w", err)
 	}
@@ -1,15 +1,17 @@
 package nhl_test
 
 import (
+	"context"
 	"go-nhl/client"
 	"testing"
 )
 
 func TestGetGameDetails(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	// Test with a valid game ID
-	details, err := client.GetGameDetails(2023020204)
+	details, err := client.GetGameDetails(ctx, 2023020204)
 	if err != nil {
 		t.Fatalf("GetGameDetails() error = %v", err)
 	}
@@ -39,7 +41,7 @@ func TestGetGameDetails(t *testing.T) {
 	}
 
 	// Game state validation
-	validStates := map[string]bool{
+	validStates := map[nhl.GameState]bool{
 		"LIVE":      true,
 		"OFF":       true,
 		"FINAL":     true,
@@ -51,9 +53,10 @@ func TestGetGameDetails(t *testing.T) {
 }
 
 func TestGetGameBoxscore(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
-	boxscore, err := client.GetGameBoxscore(2023020204)
+	boxscore, err := client.GetGameBoxscore(ctx, 2023020204)
 	if err != nil {
 		t.Fatalf("GetGameBoxscore() error = %v", err)
 	}
@@ -108,9 +111,10 @@ func validatePlayerStats(t *testing.T, stats nhl.TeamPlayerStats, teamType strin
 }
 
 func TestGetGamePlayByPlay(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
-	pbp, err := client.GetGamePlayByPlay(2023020204)
+	pbp, err := client.GetGamePlayByPlay(ctx, 2023020204)
 	if err != nil {
 		t.Fatalf("GetGamePlayByPlay() error = %v", err)
 	}
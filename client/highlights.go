@@ -1,18 +1,19 @@
 package nhl
 
 import (
+	"context"
 	"fmt"
 )
 
 // VideoItem represents a video/highlight from NHL
 type VideoItem struct {
-	Title       string `json:"title"`
-	Slug        string `json:"slug"`
-	Description string `json:"description"`
-	Duration    string `json:"duration"`
+	Title        string `json:"title"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	Duration     string `json:"duration"`
 	BrightcoveID string `json:"brightcoveId"`
-	URL         string `json:"url"`
-	Thumbnail   string `json:"thumbnail"`
+	URL          string `json:"url"`
+	Thumbnail    string `json:"thumbnail"`
 }
 
 // HighlightsResponse contains video highlights
@@ -21,9 +22,9 @@ type HighlightsResponse struct {
 }
 
 // GetGameHighlights returns video highlights for a specific game
-func (c *Client) GetGameHighlights(gameID int) (*HighlightsResponse, error) {
-	url := fmt.Sprintf("https://forge-dapi.d3.nhle.com/v2/content/en-us/videos?tags.slug=gameid-%d", gameID)
-	
+func (c *Client) GetGameHighlights(ctx context.Context, gameID GameID) (*HighlightsResponse, error) {
+	url := fmt.Sprintf("%s/content/en-us/videos?tags.slug=gameid-%d", c.forgeBaseURL, gameID)
+
 	var rawResponse struct {
 		Items []struct {
 			Title     string `json:"title"`
@@ -32,34 +33,34 @@ func (c *Client) GetGameHighlights(gameID int) (*HighlightsResponse, error) {
 				TemplateURL string `json:"templateUrl"`
 			} `json:"thumbnail"`
 			Fields struct {
-				Description string `json:"description"`
-				Duration    string `json:"duration"`
+				Description  string `json:"description"`
+				Duration     string `json:"duration"`
 				BrightcoveID string `json:"brightcoveId"`
 			} `json:"fields"`
 		} `json:"items"`
 	}
-	
-	err := c.get(url, &rawResponse)
+
+	err := c.get(ctx, url, &rawResponse)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get highlights: %v", err)
+		return nil, fmt.Errorf("failed to get highlights: %w", err)
 	}
-	
+
 	response := &HighlightsResponse{
 		Items: make([]VideoItem, 0, len(rawResponse.Items)),
 	}
-	
+
 	for _, item := range rawResponse.Items {
 		video := VideoItem{
-			Title:       item.Title,
-			Slug:        item.Slug,
-			Description: item.Fields.Description,
-			Duration:    item.Fields.Duration,
+			Title:        item.Title,
+			Slug:         item.Slug,
+			Description:  item.Fields.Description,
+			Duration:     item.Fields.Duration,
 			BrightcoveID: item.Fields.BrightcoveID,
-			URL:         fmt.Sprintf("https://www.nhl.com/video/%s", item.Slug),
-			Thumbnail:   item.Thumbnail.TemplateURL,
+			URL:          fmt.Sprintf("https://www.nhl.com/video/%s", item.Slug),
+			Thumbnail:    item.Thumbnail.TemplateURL,
 		}
 		response.Items = append(response.Items, video)
 	}
-	
+
 	return response, nil
 }
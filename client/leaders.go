@@ -1,8 +1,10 @@
 package nhl
 
 import (
+	"context"
 	"fmt"
 	"go-nhl/internal/formatters"
+	"strings"
 )
 
 // StatsLeaderPlayer represents a player in the stats leaders list
@@ -15,7 +17,7 @@ type StatsLeaderPlayer struct {
 	TeamAbbrev    string        `json:"teamAbbrev"`
 	TeamName      LanguageNames `json:"teamName"`
 	TeamLogo      string        `json:"teamLogo"`
-	Position      string        `json:"position"`
+	Position      Position      `json:"position"`
 	Value         float64       `json:"value"`
 }
 
@@ -34,7 +36,7 @@ type StatsLeadersResponse struct {
 
 // GetStatsLeaders returns the NHL stats leaders for a given season
 // If seasonID is 0, it returns stats for the current season
-func (c *Client) GetStatsLeaders(seasonID int) (*StatsLeadersResponse, error) {
+func (c *Client) GetStatsLeaders(ctx context.Context, seasonID int) (*StatsLeadersResponse, error) {
 	// If no season provided, use current season
 	if seasonID == 0 {
 		seasonID = formatters.GetCurrentSeasonID()
@@ -42,9 +44,176 @@ func (c *Client) GetStatsLeaders(seasonID int) (*StatsLeadersResponse, error) {
 
 	url := fmt.Sprintf("%s/skater-stats-leaders/%d/2", c.baseURL, seasonID)
 	var response StatsLeadersResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get stats leaders: %v", err)
+		return nil, fmt.Errorf("failed to get stats leaders: %w", err)
 	}
 	return &response, nil
 }
+
+// LeaderCategory is a stats leaders category
+type LeaderCategory string
+
+// Skater leader categories
+const (
+	LeaderPoints      LeaderCategory = "points"
+	LeaderGoals       LeaderCategory = "goals"
+	LeaderAssists     LeaderCategory = "assists"
+	LeaderGoalsPp     LeaderCategory = "goalsPp"
+	LeaderGoalsSh     LeaderCategory = "goalsSh"
+	LeaderPlusMinus   LeaderCategory = "plusMinus"
+	LeaderFaceoffs    LeaderCategory = "faceoffLeaders"
+	LeaderTOI         LeaderCategory = "toi"
+	LeaderPenaltyMins LeaderCategory = "penaltyMins"
+)
+
+// Goalie leader categories
+const (
+	LeaderWins     LeaderCategory = "wins"
+	LeaderSavePctg LeaderCategory = "savePctg"
+	LeaderGAA      LeaderCategory = "goalsAgainstAverage"
+	LeaderShutouts LeaderCategory = "shutouts"
+)
+
+// SkaterLeaderCategories lists every skater category, in display order
+var SkaterLeaderCategories = []LeaderCategory{
+	LeaderPoints, LeaderGoals, LeaderAssists, LeaderGoalsPp, LeaderGoalsSh,
+	LeaderPlusMinus, LeaderFaceoffs, LeaderTOI, LeaderPenaltyMins,
+}
+
+// GoalieLeaderCategories lists every goalie category, in display order
+var GoalieLeaderCategories = []LeaderCategory{
+	LeaderWins, LeaderSavePctg, LeaderGAA, LeaderShutouts,
+}
+
+// Goalie reports whether the category ranks goalies
+func (c LeaderCategory) Goalie() bool {
+	for _, category := range GoalieLeaderCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// valid reports whether the category is known
+func (c LeaderCategory) valid() bool {
+	if c.Goalie() {
+		return true
+	}
+	for _, category := range SkaterLeaderCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseLeaderCategories parses a comma separated list of leader categories
+// such as "points,savePctg". The words skaters and goalies expand to every
+// category of that kind.
+func ParseLeaderCategories(list string) ([]LeaderCategory, error) {
+	var categories []LeaderCategory
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "":
+			continue
+		case "skaters":
+			categories = append(categories, SkaterLeaderCategories...)
+			continue
+		case "goalies":
+			categories = append(categories, GoalieLeaderCategories...)
+			continue
+		}
+		category := LeaderCategory(name)
+		if !category.valid() {
+			return nil, fmt.Errorf("unknown leader category %q", name)
+		}
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
+
+// LeadersQuery selects the stats leaders returned by GetLeaders
+type LeadersQuery struct {
+	SeasonID   int              // If 0, the current season
+	GameType   GameType         // If 0, the regular season
+	Categories []LeaderCategory // If empty, every skater and goalie category
+	Limit      int              // Players per category: 0 for the API default, -1 for all
+}
+
+// Leaders holds stats leaders keyed by category
+type Leaders map[LeaderCategory][]StatsLeaderPlayer
+
+// Leaders returns the response keyed by category
+func (r *StatsLeadersResponse) Leaders() Leaders {
+	return Leaders{
+		LeaderPoints:      r.Points,
+		LeaderGoals:       r.Goals,
+		LeaderAssists:     r.Assists,
+		LeaderGoalsPp:     r.GoalsPp,
+		LeaderGoalsSh:     r.GoalsSh,
+		LeaderPlusMinus:   r.PlusMinus,
+		LeaderFaceoffs:    r.FaceoffLeaders,
+		LeaderTOI:         r.TOI,
+		LeaderPenaltyMins: r.PenaltyMins,
+	}
+}
+
+// GetLeaders returns skater and goalie stats leaders for the categories,
+// season and game type in query
+func (c *Client) GetLeaders(ctx context.Context, query LeadersQuery) (Leaders, error) {
+	if query.SeasonID == 0 {
+		query.SeasonID = formatters.GetCurrentSeasonID()
+	}
+	if query.GameType == 0 {
+		query.GameType = GameTypeRegularSeason
+	}
+	categories := query.Categories
+	if len(categories) == 0 {
+		categories = append(append([]LeaderCategory{}, SkaterLeaderCategories...), GoalieLeaderCategories...)
+	}
+
+	var skaters, goalies []string
+	for _, category := range categories {
+		if !category.valid() {
+			return nil, fmt.Errorf("unknown leader category %q", category)
+		}
+		if category.Goalie() {
+			goalies = append(goalies, string(category))
+		} else {
+			skaters = append(skaters, string(category))
+		}
+	}
+
+	leaders := make(Leaders, len(categories))
+	if len(skaters) > 0 {
+		if err := c.getLeaders(ctx, "skater", query, skaters, leaders); err != nil {
+			return nil, err
+		}
+	}
+	if len(goalies) > 0 {
+		if err := c.getLeaders(ctx, "goalie", query, goalies, leaders); err != nil {
+			return nil, err
+		}
+	}
+	return leaders, nil
+}
+
+// getLeaders fetches one kind of stats leaders, skater or goalie, into leaders
+func (c *Client) getLeaders(ctx context.Context, kind string, query LeadersQuery, categories []string, leaders Leaders) error {
+	url := fmt.Sprintf("%s/%s-stats-leaders/%d/%d?categories=%s", c.baseURL, kind, query.SeasonID, query.GameType, strings.Join(categories, ","))
+	if query.Limit != 0 {
+		url += fmt.Sprintf("&limit=%d", query.Limit)
+	}
+
+	var response map[LeaderCategory][]StatsLeaderPlayer
+	if err := c.get(ctx, url, &response); err != nil {
+		return fmt.Errorf("failed to get %s stats leaders: %w", kind, err)
+	}
+	for _, category := range categories {
+		leaders[LeaderCategory(category)] = response[LeaderCategory(category)]
+	}
+	return nil
+}
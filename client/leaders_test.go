@@ -1,15 +1,18 @@
 package nhl
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"go-nhl/internal/formatters"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
 func TestGetStatsLeaders(t *testing.T) {
+	ctx := context.Background()
 	testCases := []struct {
 		name           string
 		seasonID       int
@@ -64,7 +67,7 @@ func TestGetStatsLeaders(t *testing.T) {
 			}
 
 			// Call GetStatsLeaders
-			leaders, err := client.GetStatsLeaders(tc.seasonID)
+			leaders, err := client.GetStatsLeaders(ctx, tc.seasonID)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
@@ -90,6 +93,7 @@ func TestGetStatsLeaders(t *testing.T) {
 }
 
 func TestGetStatsLeadersError(t *testing.T) {
+	ctx := context.Background()
 	// Create test server that returns an error
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -103,8 +107,86 @@ func TestGetStatsLeadersError(t *testing.T) {
 	}
 
 	// Call GetStatsLeaders and verify error
-	_, err := client.GetStatsLeaders(0)
+	_, err := client.GetStatsLeaders(ctx, 0)
 	if err == nil {
 		t.Error("Expected error, got nil")
 	}
 }
+
+func TestGetLeaders(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		player := StatsLeaderPlayer{LastName: LanguageNames{Default: "Hellebuyck"}, Value: 37}
+		if r.URL.Path == "/skater-stats-leaders/20232024/3" {
+			player = StatsLeaderPlayer{LastName: LanguageNames{Default: "McDavid"}, Value: 42}
+		}
+		response := map[string][]StatsLeaderPlayer{}
+		for _, category := range strings.Split(r.URL.Query().Get("categories"), ",") {
+			response[category] = []StatsLeaderPlayer{player}
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		baseURL:    server.URL,
+		httpClient: server.Client(),
+	}
+
+	leaders, err := client.GetLeaders(ctx, LeadersQuery{
+		SeasonID:   20232024,
+		GameType:   GameTypePlayoffs,
+		Categories: []LeaderCategory{LeaderPoints, LeaderWins, LeaderAssists},
+		Limit:      3,
+	})
+	if err != nil {
+		t.Fatalf("GetLeaders() error = %v", err)
+	}
+
+	wantRequests := []string{
+		"/skater-stats-leaders/20232024/3?categories=points,assists&limit=3",
+		"/goalie-stats-leaders/20232024/3?categories=wins&limit=3",
+	}
+	if strings.Join(requests, " ") != strings.Join(wantRequests, " ") {
+		t.Errorf("GetLeaders() requested %v, want %v", requests, wantRequests)
+	}
+	if len(leaders) != 3 {
+		t.Errorf("GetLeaders() returned %d categories, want 3", len(leaders))
+	}
+	if got := leaders[LeaderAssists][0].LastName.Default; got != "McDavid" {
+		t.Errorf("assists leader = %s, want McDavid", got)
+	}
+	if got := leaders[LeaderWins][0].LastName.Default; got != "Hellebuyck" {
+		t.Errorf("wins leader = %s, want Hellebuyck", got)
+	}
+
+	if _, err := client.GetLeaders(ctx, LeadersQuery{Categories: []LeaderCategory{"hits"}}); err == nil {
+		t.Error("GetLeaders() with an unknown category returned no error")
+	}
+}
+
+func TestParseLeaderCategories(t *testing.T) {
+	tests := []struct {
+		list    string
+		want    []LeaderCategory
+		wantErr bool
+	}{
+		{list: "", want: nil},
+		{list: "points, savePctg", want: []LeaderCategory{LeaderPoints, LeaderSavePctg}},
+		{list: "goalies", want: GoalieLeaderCategories},
+		{list: "points,hits", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLeaderCategories(tt.list)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLeaderCategories(%q) error = %v, wantErr %v", tt.list, err, tt.wantErr)
+			continue
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("ParseLeaderCategories(%q) = %v, want %v", tt.list, got, tt.want)
+		}
+	}
+}
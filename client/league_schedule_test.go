@@ -0,0 +1,94 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetLeagueSchedule(t *testing.T) {
+	// Weeks overlap by a day, as they do around the All-Star break, so the
+	// game on 2024-02-11 is served twice
+	weeks := map[string]Schedule{
+		"2024-02-05": {NextStartDate: "2024-02-11", GameWeek: []GameDay{
+			{Date: "2024-02-05", Games: []Game{{ID: 1, GameType: 2, GameState: "OFF", AwayTeam: Team{Abbrev: "NYR"}, HomeTeam: Team{Abbrev: "CHI"}}}},
+			{Date: "2024-02-07"},
+			{Date: "2024-02-11", Games: []Game{{ID: 2, GameType: 2, GameState: "FUT", AwayTeam: Team{Abbrev: "TOR"}, HomeTeam: Team{Abbrev: "NYR"}}}},
+		}},
+		"2024-02-11": {NextStartDate: "2024-02-18", GameWeek: []GameDay{
+			{Date: "2024-02-11", Games: []Game{{ID: 2, GameType: 2, GameState: "FUT", AwayTeam: Team{Abbrev: "TOR"}, HomeTeam: Team{Abbrev: "NYR"}}}},
+			{Date: "2024-02-13", Games: []Game{{ID: 3, GameType: 2, GameState: "FUT", AwayTeam: Team{Abbrev: "BOS"}, HomeTeam: Team{Abbrev: "DAL"}}}},
+			{Date: "2024-02-14", Games: []Game{{ID: 4, GameType: 2, GameState: "FUT", AwayTeam: Team{Abbrev: "NYR"}, HomeTeam: Team{Abbrev: "BOS"}, TVBroadcasts: []TVBroadcast{{Network: "ESPN", CountryCode: "US", Market: MarketNational}, {Network: "SN", CountryCode: "CA", Market: MarketNational}}}}},
+		}},
+	}
+	var requested []string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			date := strings.TrimPrefix(req.URL.Path, "/v1/schedule/")
+			requested = append(requested, date)
+			return mockResponse(http.StatusOK, weeks[date])
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+	ctx := context.Background()
+
+	schedule, err := client.GetLeagueSchedule(ctx, "2024-02-05", "2024-02-13", nil)
+	if err != nil {
+		t.Fatalf("GetLeagueSchedule() error = %v", err)
+	}
+	if strings.Join(requested, ",") != "2024-02-05,2024-02-11" {
+		t.Errorf("GetLeagueSchedule() requested weeks %v", requested)
+	}
+	var ids []int
+	for _, game := range schedule.Games() {
+		ids = append(ids, int(game.ID))
+	}
+	if !equalInts(ids, []int{1, 2, 3}) {
+		t.Errorf("Games() = %v, want [1 2 3]", ids)
+	}
+	if len(schedule.GameDays) != 3 || schedule.GameDays[1].Date != "2024-02-11" {
+		t.Errorf("GameDays = %+v, want three days without 2024-02-07", schedule.GameDays)
+	}
+
+	tests := []struct {
+		name   string
+		filter ScheduleFilter
+		want   []int
+	}{
+		{name: "Team", filter: ScheduleFilter{Teams: []string{"nyr"}}, want: []int{1, 2, 4}},
+		{name: "Home", filter: ScheduleFilter{Teams: []string{"NYR"}, HomeAway: HomeOnly}, want: []int{2}},
+		{name: "Away", filter: ScheduleFilter{Teams: []string{"NYR", "BOS"}, HomeAway: AwayOnly}, want: []int{1, 3, 4}},
+		{name: "State", filter: ScheduleFilter{GameStates: []GameState{GameStateOff}}, want: []int{1}},
+		{name: "Game type", filter: ScheduleFilter{GameTypes: []GameType{GameTypePlayoffs}}, want: nil},
+		{name: "Broadcast", filter: ScheduleFilter{Broadcasts: &BroadcastFilter{Countries: []string{"US"}}}, want: []int{4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := client.GetLeagueSchedule(ctx, "2024-02-05", "2024-02-17", &tt.filter)
+			if err != nil {
+				t.Fatalf("GetLeagueSchedule() error = %v", err)
+			}
+			var ids []int
+			for _, game := range schedule.Games() {
+				ids = append(ids, int(game.ID))
+			}
+			if !equalInts(ids, tt.want) {
+				t.Errorf("Games() = %v, want %v", ids, tt.want)
+			}
+			if tt.filter.Broadcasts != nil {
+				for _, game := range schedule.Games() {
+					if len(game.TVBroadcasts) != 1 {
+						t.Errorf("game %d kept broadcasts %+v, want only the matching one", game.ID, game.TVBroadcasts)
+					}
+				}
+			}
+		})
+	}
+
+	for _, dates := range [][2]string{{"2024-02-13", "2024-02-05"}, {"2024-2-5", "2024-02-13"}, {"2023-01-01", "2024-02-13"}} {
+		if _, err := client.GetLeagueSchedule(ctx, dates[0], dates[1], nil); err == nil {
+			t.Errorf("GetLeagueSchedule(%s, %s) returned no error", dates[0], dates[1])
+		}
+	}
+}
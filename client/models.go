@@ -17,9 +17,9 @@ type GameDay struct {
 
 // Game represents an NHL game
 type Game struct {
-	ID                int              `json:"id"`
+	ID                GameID           `json:"id"`
 	Season            int              `json:"season"`
-	GameType          int              `json:"gameType"`
+	GameType          GameType         `json:"gameType"`
 	GameDate          string           `json:"gameDate"`
 	GameCenterLink    string           `json:"gameCenterLink"`
 	Venue             Venue            `json:"venue"`
@@ -27,7 +27,7 @@ type Game struct {
 	EasternUTCOffset  string           `json:"easternUTCOffset"`
 	VenueUTCOffset    string           `json:"venueUTCOffset"`
 	TVBroadcasts      []TVBroadcast    `json:"tvBroadcasts"`
-	GameState         string           `json:"gameState"`
+	GameState         GameState        `json:"gameState"`
 	GameScheduleState string           `json:"gameScheduleState"`
 	AwayTeam          Team             `json:"awayTeam"`
 	HomeTeam          Team             `json:"homeTeam"`
@@ -67,7 +67,7 @@ type LanguageNames struct {
 
 // GameOutcome represents the outcome of a game
 type GameOutcome struct {
-	LastPeriodType string `json:"lastPeriodType"`
+	LastPeriodType PeriodType `json:"lastPeriodType"`
 }
 
 // PlayerInfo represents detailed information about a player
@@ -75,7 +75,7 @@ type PlayerInfo struct {
 	ID           int      `json:"id"`
 	FirstName    NameInfo `json:"firstName"`
 	LastName     NameInfo `json:"lastName"`
-	Position     string   `json:"positionCode"`
+	Position     Position `json:"positionCode"`
 	JerseyNumber int      `json:"sweaterNumber"`
 	Height       int      `json:"heightInInches"`
 	Weight       int      `json:"weightInPounds"`
@@ -141,9 +141,9 @@ type TVBroadcast struct {
 
 // PeriodDescriptor represents information about a game period
 type PeriodDescriptor struct {
-	Number               int    `json:"number"`
-	PeriodType           string `json:"periodType"`
-	MaxRegulationPeriods int    `json:"maxRegulationPeriods"`
+	Number               int        `json:"number"`
+	PeriodType           PeriodType `json:"periodType"`
+	MaxRegulationPeriods int        `json:"maxRegulationPeriods"`
 }
 
 // FilteredScoreboardResponse represents the NHL score response for a specific date
@@ -166,6 +166,9 @@ type TeamInfo struct {
 	TriCode      string        `json:"triCode"`
 	FranchiseID  int           `json:"franchiseId"`
 	Active       bool          `json:"active"`
+	Conference   string        `json:"conference,omitempty"`
+	Division     string        `json:"division,omitempty"`
+	Logo         string        `json:"logo,omitempty"`
 }
 
 // RosterResponse represents the response from the roster endpoint
@@ -175,45 +178,53 @@ type RosterResponse struct {
 	Goalies    []PlayerInfo `json:"goalies"`
 }
 
-// PlayerSearchResult represents a player found during search
+// PlayerSearchResult represents a player found during search. TeamID and
+// TeamAbbrev are empty for players not on an NHL roster; LastTeamID and
+// LastTeamAbbrev give the team they last played for.
 type PlayerSearchResult struct {
-	FirstName    NameInfo `json:"firstName"`
-	LastName     NameInfo `json:"lastName"`
-	Position     string   `json:"position"`
-	JerseyNumber int      `json:"jerseyNumber"`
-	TeamID       int      `json:"teamId"`
-	TeamAbbrev   string   `json:"teamAbbrev"`
-	PlayerID     int      `json:"playerId"`
+	FirstName      NameInfo `json:"firstName"`
+	LastName       NameInfo `json:"lastName"`
+	Position       Position `json:"position"`
+	JerseyNumber   int      `json:"jerseyNumber"`
+	TeamID         int      `json:"teamId"`
+	TeamAbbrev     string   `json:"teamAbbrev"`
+	PlayerID       int      `json:"playerId"`
+	Active         bool     `json:"active"`
+	BirthYear      int      `json:"birthYear,omitempty"`
+	BirthCountry   string   `json:"birthCountry,omitempty"`
+	LastTeamID     int      `json:"lastTeamId,omitempty"`
+	LastTeamAbbrev string   `json:"lastTeamAbbrev,omitempty"`
+	LastSeasonID   int      `json:"lastSeasonId,omitempty"`
 }
 
 // SkaterStats represents statistics for a skater
 type SkaterStats struct {
-	Assists            int     `json:"assists"`
-	EvenStrengthGoals  int     `json:"evGoals"`
-	EvenStrengthPoints int     `json:"evPoints"`
-	FaceoffWinPct      float64 `json:"faceoffWinPct"`
-	GameWinningGoals   int     `json:"gameWinningGoals"`
-	GamesPlayed        int     `json:"gamesPlayed"`
-	Goals              int     `json:"goals"`
-	LastName           string  `json:"lastName"`
-	OvertimeGoals      int     `json:"otGoals"`
-	PenaltyMinutes     int     `json:"penaltyMinutes"`
-	PlayerID           int     `json:"playerId"`
-	PlusMinus          int     `json:"plusMinus"`
-	Points             int     `json:"points"`
-	PointsPerGame      float64 `json:"pointsPerGame"`
-	PositionCode       string  `json:"positionCode"`
-	PowerPlayGoals     int     `json:"ppGoals"`
-	PowerPlayPoints    int     `json:"ppPoints"`
-	SeasonID           int     `json:"seasonId"`
-	ShortHandedGoals   int     `json:"shGoals"`
-	ShortHandedPoints  int     `json:"shPoints"`
-	ShootingPct        float64 `json:"shootingPct"`
-	ShootsCatches      string  `json:"shootsCatches"`
-	Shots              int     `json:"shots"`
-	FullName           string  `json:"skaterFullName"`
-	TeamAbbrev         string  `json:"teamAbbrevs"`
-	TimeOnIcePerGame   float64 `json:"timeOnIcePerGame"`
+	Assists            int      `json:"assists"`
+	EvenStrengthGoals  int      `json:"evGoals"`
+	EvenStrengthPoints int      `json:"evPoints"`
+	FaceoffWinPct      float64  `json:"faceoffWinPct"`
+	GameWinningGoals   int      `json:"gameWinningGoals"`
+	GamesPlayed        int      `json:"gamesPlayed"`
+	Goals              int      `json:"goals"`
+	LastName           string   `json:"lastName"`
+	OvertimeGoals      int      `json:"otGoals"`
+	PenaltyMinutes     int      `json:"penaltyMinutes"`
+	PlayerID           int      `json:"playerId"`
+	PlusMinus          int      `json:"plusMinus"`
+	Points             int      `json:"points"`
+	PointsPerGame      float64  `json:"pointsPerGame"`
+	PositionCode       Position `json:"positionCode"`
+	PowerPlayGoals     int      `json:"ppGoals"`
+	PowerPlayPoints    int      `json:"ppPoints"`
+	SeasonID           int      `json:"seasonId"`
+	ShortHandedGoals   int      `json:"shGoals"`
+	ShortHandedPoints  int      `json:"shPoints"`
+	ShootingPct        float64  `json:"shootingPct"`
+	ShootsCatches      string   `json:"shootsCatches"`
+	Shots              int      `json:"shots"`
+	FullName           string   `json:"skaterFullName"`
+	TeamAbbrev         string   `json:"teamAbbrevs"`
+	TimeOnIcePerGame   float64  `json:"timeOnIcePerGame"`
 }
 
 // GoalieStats represents statistics for a goalie
@@ -269,29 +280,30 @@ type StatsFilter struct {
 // PlayerLandingResponse represents the response from the player landing page API
 type PlayerLandingResponse struct {
 	SeasonTotals []SeasonTotal `json:"seasonTotals"`
+	DraftDetails *DraftDetails `json:"draftDetails,omitempty"`
 }
 
 // SeasonTotal represents a player's stats for a single season
 type SeasonTotal struct {
-	Assists            int     `json:"assists,omitempty"`
-	AvgTOI             string  `json:"avgToi,omitempty"`
-	FaceoffWinningPctg float64 `json:"faceoffWinningPctg,omitempty"`
-	GameTypeID         int     `json:"gameTypeId"`
-	GameWinningGoals   int     `json:"gameWinningGoals,omitempty"`
-	GamesPlayed        int     `json:"gamesPlayed"`
-	Goals              int     `json:"goals,omitempty"`
-	LeagueAbbrev       string  `json:"leagueAbbrev"`
-	OTGoals            int     `json:"otGoals,omitempty"`
-	PenaltyMinutes     int     `json:"pim,omitempty"`
-	PlusMinus          int     `json:"plusMinus,omitempty"`
-	Points             int     `json:"points"`
-	PowerPlayGoals     int     `json:"powerPlayGoals,omitempty"`
-	PowerPlayPoints    int     `json:"powerPlayPoints,omitempty"`
-	Season             int     `json:"season"`
-	ShootingPctg       float64 `json:"shootingPctg,omitempty"`
-	ShorthandedGoals   int     `json:"shorthandedGoals,omitempty"`
-	ShorthandedPoints  int     `json:"shorthandedPoints,omitempty"`
-	Shots              int     `json:"shots,omitempty"`
+	Assists            int      `json:"assists,omitempty"`
+	AvgTOI             string   `json:"avgToi,omitempty"`
+	FaceoffWinningPctg float64  `json:"faceoffWinningPctg,omitempty"`
+	GameTypeID         GameType `json:"gameTypeId"`
+	GameWinningGoals   int      `json:"gameWinningGoals,omitempty"`
+	GamesPlayed        int      `json:"gamesPlayed"`
+	Goals              int      `json:"goals,omitempty"`
+	LeagueAbbrev       string   `json:"leagueAbbrev"`
+	OTGoals            int      `json:"otGoals,omitempty"`
+	PenaltyMinutes     int      `json:"pim,omitempty"`
+	PlusMinus          int      `json:"plusMinus,omitempty"`
+	Points             int      `json:"points"`
+	PowerPlayGoals     int      `json:"powerPlayGoals,omitempty"`
+	PowerPlayPoints    int      `json:"powerPlayPoints,omitempty"`
+	Season             int      `json:"season"`
+	ShootingPctg       float64  `json:"shootingPctg,omitempty"`
+	ShorthandedGoals   int      `json:"shorthandedGoals,omitempty"`
+	ShorthandedPoints  int      `json:"shorthandedPoints,omitempty"`
+	Shots              int      `json:"shots,omitempty"`
 	TeamName           struct {
 		Default string `json:"default"`
 	} `json:"teamName"`
@@ -304,13 +316,14 @@ type TeamScheduleResponse struct {
 
 // ScheduleGame represents a game in a team's schedule
 type ScheduleGame struct {
-	ID             int            `json:"id"`
+	ID             GameID         `json:"id"`
 	Season         int            `json:"season"`
-	GameType       int            `json:"gameType"`
+	GameType       GameType       `json:"gameType"`
 	GameDate       string         `json:"gameDate"`
 	StartTimeUTC   string         `json:"startTimeUTC"`
 	VenueUTCOffset string         `json:"venueUTCOffset"`
-	GameState      string         `json:"gameState"`
+	GameState      GameState      `json:"gameState"`
+	TVBroadcasts   []TVBroadcast  `json:"tvBroadcasts"`
 	HomeTeam       TeamInSchedule `json:"homeTeam"`
 	AwayTeam       TeamInSchedule `json:"awayTeam"`
 	GameCenterLink string         `json:"gameCenterLink"`
@@ -337,7 +350,10 @@ type StandingsResponse struct {
 // StandingsTeam represents a team's standings information
 type StandingsTeam struct {
 	TeamName          LanguageNames `json:"teamName"`
+	TeamCommonName    LanguageNames `json:"teamCommonName"`
+	PlaceName         LanguageNames `json:"placeName"`
 	TeamAbbrev        TeamAbbrev    `json:"teamAbbrev"`
+	TeamLogo          string        `json:"teamLogo"`
 	Conference        string        `json:"conferenceName"`
 	Division          string        `json:"divisionName"`
 	Wins              int           `json:"wins"`
@@ -376,13 +392,13 @@ type TeamAbbrev struct {
 
 // GameDetails represents detailed information about a specific game
 type GameDetails struct {
-	ID           int           `json:"id"`
-	GameType     int           `json:"gameType"`
+	ID           GameID        `json:"id"`
+	GameType     GameType      `json:"gameType"`
 	Season       int           `json:"season"`
 	GameDate     string        `json:"gameDate"`
 	StartTimeUTC string        `json:"startTimeUTC"`
 	Venue        Venue         `json:"venue"`
-	GameState    string        `json:"gameState"`
+	GameState    GameState     `json:"gameState"`
 	HomeTeam     DetailedTeam  `json:"homeTeam"`
 	AwayTeam     DetailedTeam  `json:"awayTeam"`
 	Clock        GameClock     `json:"clock"`
@@ -425,7 +441,7 @@ type PeriodPenalties struct {
 
 // GoalEvent represents a goal scored in the game
 type GoalEvent struct {
-	SituationCode     string         `json:"situationCode"`
+	SituationCode     SituationCode  `json:"situationCode"`
 	Strength          string         `json:"strength"`
 	PlayerID          int            `json:"playerId"`
 	FirstName         LanguageNames  `json:"firstName"`
@@ -477,7 +493,7 @@ type StarPlayer struct {
 	Headshot   string        `json:"headshot"`
 	Name       LanguageNames `json:"name"`
 	SweaterNo  int           `json:"sweaterNo"`
-	Position   string        `json:"position"`
+	Position   Position      `json:"position"`
 	Goals      int           `json:"goals,omitempty"`
 	Assists    int           `json:"assists,omitempty"`
 	Points     int           `json:"points,omitempty"`
@@ -515,7 +531,7 @@ type GameTeamLeaders struct {
 type PlayerBrief struct {
 	ID       int           `json:"id"`
 	Name     LanguageNames `json:"name"`
-	Position string        `json:"position"`
+	Position Position      `json:"position"`
 	Number   string        `json:"sweaterNumber"`
 }
 
@@ -529,13 +545,13 @@ type GameClock struct {
 
 // BoxscoreResponse represents the boxscore data for a game
 type BoxscoreResponse struct {
-	ID                int             `json:"id"`
+	ID                GameID          `json:"id"`
 	Season            int             `json:"season"`
-	GameType          int             `json:"gameType"`
+	GameType          GameType        `json:"gameType"`
 	GameDate          string          `json:"gameDate"`
 	StartTimeUTC      string          `json:"startTimeUTC"`
 	Venue             Venue           `json:"venue"`
-	GameState         string          `json:"gameState"`
+	GameState         GameState       `json:"gameState"`
 	HomeTeam          DetailedTeam    `json:"homeTeam"`
 	AwayTeam          DetailedTeam    `json:"awayTeam"`
 	PlayerByGameStats PlayerGameStats `json:"playerByGameStats"`
@@ -559,7 +575,7 @@ type PlayerStats struct {
 	PlayerID          int           `json:"playerId"`
 	SweaterNumber     int           `json:"sweaterNumber"`
 	Name              LanguageNames `json:"name"`
-	Position          string        `json:"position"`
+	Position          Position      `json:"position"`
 	Goals             int           `json:"goals"`
 	Assists           int           `json:"assists"`
 	Points            int           `json:"points"`
@@ -581,7 +597,7 @@ type GoalieGameStats struct {
 	PlayerID                 int           `json:"playerId"`
 	SweaterNumber            int           `json:"sweaterNumber"`
 	Name                     LanguageNames `json:"name"`
-	Position                 string        `json:"position"`
+	Position                 Position      `json:"position"`
 	EvenStrengthShotsAgainst string        `json:"evenStrengthShotsAgainst"`
 	PowerPlayShotsAgainst    string        `json:"powerPlayShotsAgainst"`
 	ShorthandedShotsAgainst  string        `json:"shorthandedShotsAgainst"`
@@ -641,14 +657,25 @@ type Official struct {
 
 // Coach represents a team coach
 type Coach struct {
-	Name     string `json:"name"`
-	Position string `json:"position"`
+	Name     string   `json:"name"`
+	Position Position `json:"position"`
 }
 
 // PlayByPlayResponse represents play-by-play data for a game
 type PlayByPlayResponse struct {
-	Plays       []PlayEvent  `json:"plays"`
-	RosterSpots []RosterSpot `json:"rosterSpots"`
+	ID               GameID           `json:"id"`
+	Season           int              `json:"season"`
+	GameType         GameType         `json:"gameType"`
+	GameDate         string           `json:"gameDate"`
+	StartTimeUTC     string           `json:"startTimeUTC"`
+	Venue            Venue            `json:"venue"`
+	GameState        GameState        `json:"gameState"`
+	PeriodDescriptor PeriodDescriptor `json:"periodDescriptor"`
+	Clock            GameClock        `json:"clock"`
+	AwayTeam         Team             `json:"awayTeam"`
+	HomeTeam         Team             `json:"homeTeam"`
+	Plays            []PlayEvent      `json:"plays"`
+	RosterSpots      []RosterSpot     `json:"rosterSpots"`
 }
 
 // RosterSpot represents a player in the game roster
@@ -658,57 +685,65 @@ type RosterSpot struct {
 	FirstName     LanguageNames `json:"firstName"`
 	LastName      LanguageNames `json:"lastName"`
 	SweaterNumber int           `json:"sweaterNumber"`
-	PositionCode  string        `json:"positionCode"`
+	PositionCode  Position      `json:"positionCode"`
 	Headshot      string        `json:"headshot"`
 }
 
 // PlayEvent represents a single event in the game
 type PlayEvent struct {
-	EventID          int              `json:"eventId"`
-	PeriodDescriptor PeriodDescriptor `json:"periodDescriptor"`
-	TimeInPeriod     string           `json:"timeInPeriod"`
-	TimeRemaining    string           `json:"timeRemaining"`
-	SituationCode    string           `json:"situationCode"`
-	TypeCode         int              `json:"typeCode"`
-	TypeDescKey      string           `json:"typeDescKey"`
-	Details          EventDetails     `json:"details"`
+	EventID               int              `json:"eventId"`
+	PeriodDescriptor      PeriodDescriptor `json:"periodDescriptor"`
+	TimeInPeriod          string           `json:"timeInPeriod"`
+	TimeRemaining         string           `json:"timeRemaining"`
+	SituationCode         SituationCode    `json:"situationCode"`
+	HomeTeamDefendingSide string           `json:"homeTeamDefendingSide,omitempty"`
+	TypeCode              int              `json:"typeCode"`
+	TypeDescKey           EventType        `json:"typeDescKey"`
+	SortOrder             int              `json:"sortOrder,omitempty"`
+	Details               EventDetails     `json:"details"`
 }
 
 // EventDetails represents details about a play event
 type EventDetails struct {
-	EventOwnerTeamID    int     `json:"eventOwnerTeamId,omitempty"`
-	XCoord              float64 `json:"xCoord,omitempty"`
-	YCoord              float64 `json:"yCoord,omitempty"`
-	ZoneCode            string  `json:"zoneCode,omitempty"`
-	ShotType            string  `json:"shotType,omitempty"`
-	ShootingPlayerID    int     `json:"shootingPlayerId,omitempty"`
-	GoalieInNetID       int     `json:"goalieInNetId,omitempty"`
-	BlockingPlayerID    int     `json:"blockingPlayerId,omitempty"`
-	HittingPlayerID     int     `json:"hittingPlayerId,omitempty"`
-	HitteePlayerID      int     `json:"hitteePlayerId,omitempty"`
-	WinningPlayerID     int     `json:"winningPlayerId,omitempty"`
-	LosingPlayerID      int     `json:"losingPlayerId,omitempty"`
-	Reason              string  `json:"reason,omitempty"`
-	TypeCode            string  `json:"typeCode,omitempty"`
-	DescKey             string  `json:"descKey,omitempty"`
-	Duration            int     `json:"duration,omitempty"`
-	CommittedByPlayerID int     `json:"committedByPlayerId,omitempty"`
-	DrawnByPlayerID     int     `json:"drawnByPlayerId,omitempty"`
-	AwaySOG             int     `json:"awaySOG,omitempty"`
-	HomeSOG             int     `json:"homeSOG,omitempty"`
-	ScoringPlayerID     int     `json:"scoringPlayerId,omitempty"`
-	ScoringPlayerTotal  int     `json:"scoringPlayerTotal,omitempty"`
-	Assist1PlayerID     int     `json:"assist1PlayerId,omitempty"`
-	Assist1PlayerTotal  int     `json:"assist1PlayerTotal,omitempty"`
-	Assist2PlayerID     int     `json:"assist2PlayerId,omitempty"`
-	Assist2PlayerTotal  int     `json:"assist2PlayerTotal,omitempty"`
+	EventOwnerTeamID        int     `json:"eventOwnerTeamId,omitempty"`
+	XCoord                  float64 `json:"xCoord,omitempty"`
+	YCoord                  float64 `json:"yCoord,omitempty"`
+	ZoneCode                string  `json:"zoneCode,omitempty"`
+	ShotType                string  `json:"shotType,omitempty"`
+	ShootingPlayerID        int     `json:"shootingPlayerId,omitempty"`
+	GoalieInNetID           int     `json:"goalieInNetId,omitempty"`
+	BlockingPlayerID        int     `json:"blockingPlayerId,omitempty"`
+	HittingPlayerID         int     `json:"hittingPlayerId,omitempty"`
+	HitteePlayerID          int     `json:"hitteePlayerId,omitempty"`
+	WinningPlayerID         int     `json:"winningPlayerId,omitempty"`
+	LosingPlayerID          int     `json:"losingPlayerId,omitempty"`
+	Reason                  string  `json:"reason,omitempty"`
+	TypeCode                string  `json:"typeCode,omitempty"`
+	DescKey                 string  `json:"descKey,omitempty"`
+	Duration                int     `json:"duration,omitempty"`
+	CommittedByPlayerID     int     `json:"committedByPlayerId,omitempty"`
+	DrawnByPlayerID         int     `json:"drawnByPlayerId,omitempty"`
+	AwaySOG                 int     `json:"awaySOG,omitempty"`
+	HomeSOG                 int     `json:"homeSOG,omitempty"`
+	ScoringPlayerID         int     `json:"scoringPlayerId,omitempty"`
+	ScoringPlayerTotal      int     `json:"scoringPlayerTotal,omitempty"`
+	Assist1PlayerID         int     `json:"assist1PlayerId,omitempty"`
+	Assist1PlayerTotal      int     `json:"assist1PlayerTotal,omitempty"`
+	Assist2PlayerID         int     `json:"assist2PlayerId,omitempty"`
+	Assist2PlayerTotal      int     `json:"assist2PlayerTotal,omitempty"`
+	AwayScore               int     `json:"awayScore,omitempty"`
+	HomeScore               int     `json:"homeScore,omitempty"`
+	PlayerID                int     `json:"playerId,omitempty"`
+	ServedByPlayerID        int     `json:"servedByPlayerId,omitempty"`
+	SecondaryReason         string  `json:"secondaryReason,omitempty"`
+	HighlightClipSharingURL string  `json:"highlightClipSharingUrl,omitempty"`
 }
 
 // GameStoryResponse represents the game story/narrative
 type GameStoryResponse struct {
-	GameID            int              `json:"id"`
+	GameID            GameID           `json:"id"`
 	Season            int              `json:"season"`
-	GameType          int              `json:"gameType"`
+	GameType          GameType         `json:"gameType"`
 	GameDate          string           `json:"gameDate"`
 	Venue             Venue            `json:"venue"`
 	VenueLocation     VenueLocation    `json:"venueLocation"`
@@ -717,7 +752,7 @@ type GameStoryResponse struct {
 	VenueUTCOffset    string           `json:"venueUTCOffset"`
 	VenueTimezone     string           `json:"venueTimezone"`
 	TVBroadcasts      []TVBroadcast    `json:"tvBroadcasts"`
-	GameState         string           `json:"gameState"`
+	GameState         GameState        `json:"gameState"`
 	GameScheduleState string           `json:"gameScheduleState"`
 	HomeTeam          Team             `json:"homeTeam"`
 	AwayTeam          Team             `json:"awayTeam"`
@@ -742,7 +777,7 @@ type GameSituation struct {
 		Abbrev   string `json:"abbrev"`
 		Strength int    `json:"strength"`
 	} `json:"awayTeam"`
-	SituationCode    string `json:"situationCode"`
-	TimeRemaining    string `json:"timeRemaining"`
-	SecondsRemaining int    `json:"secondsRemaining"`
+	SituationCode    SituationCode `json:"situationCode"`
+	TimeRemaining    string        `json:"timeRemaining"`
+	SecondsRemaining int           `json:"secondsRemaining"`
 }
@@ -0,0 +1,751 @@
+// Package nhltest provides a fake NHL API server for tests.
+//
+// The server imitates the web API, the forge content API, the stats REST
+// API and the player search API used by the client, serving canned
+// fixtures for a small slice of the 2023-24 season built around
+// FixtureGameID, NYR at CHI on FixtureDate. Tests can script a game
+// through its states, make endpoints fail and slow responses down.
+package nhltest
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	nhl "go-nhl/client"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Fixture identifiers
+const (
+	FixtureGameID      = 2024020750 // NYR at CHI, the only game with gamecenter data
+	FixtureOtherGameID = 2024020751 // TOR at BOS on the same date
+	FixtureDate        = "2024-02-09"
+	FixtureSeason      = 20232024
+	FixturePlayer      = 8480036 // Miro Heiskanen, DAL
+	FixtureGoalie      = 8478048 // Igor Shesterkin, NYR
+	FixtureScorer      = 8478550 // Artemi Panarin, NYR
+	FixtureRookie      = 8484144 // Connor Bedard, CHI
+)
+
+// GameStep is one state of a scripted game
+type GameStep struct {
+	State          nhl.GameState
+	Period         int
+	TimeRemaining  string
+	InIntermission bool
+	AwayScore      int
+	HomeScore      int
+}
+
+// Progression scripts FixtureGameID from warmups to a final
+var Progression = []GameStep{
+	{State: nhl.GameStatePregame},
+	{State: nhl.GameStateLive, Period: 1, TimeRemaining: "13:48", AwayScore: 1},
+	{State: nhl.GameStateLive, Period: 2, TimeRemaining: "00:00", InIntermission: true, AwayScore: 2, HomeScore: 1},
+	{State: nhl.GameStateCritical, Period: 3, TimeRemaining: "01:19", AwayScore: 4, HomeScore: 1},
+	{State: nhl.GameStateFinal, Period: 3, TimeRemaining: "00:00", AwayScore: 4, HomeScore: 1},
+}
+
+// Server is a fake NHL API. Point a client at it with Client or with
+// WebURL, ForgeURL, StatsURL and SearchURL.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses map[string][]byte
+	failures  map[string]*failure
+	delays    map[string]time.Duration
+	scripts   map[int]*script
+	requests  []string
+}
+
+type failure struct {
+	status    int
+	remaining int // 0 fails forever
+}
+
+type script struct {
+	steps []GameStep
+	index int
+}
+
+// NewServer starts a fake NHL API server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		responses: make(map[string][]byte),
+		failures:  make(map[string]*failure),
+		delays:    make(map[string]time.Duration),
+		scripts:   make(map[int]*script),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// WebURL returns the base URL of the fake web API
+func (s *Server) WebURL() string {
+	return s.URL + "/v1"
+}
+
+// ForgeURL returns the base URL of the fake content API
+func (s *Server) ForgeURL() string {
+	return s.URL + "/v2"
+}
+
+// StatsURL returns the base URL of the fake stats REST API
+func (s *Server) StatsURL() string {
+	return s.URL + "/stats"
+}
+
+// SearchURL returns the base URL of the fake player search API
+func (s *Server) SearchURL() string {
+	return s.URL + "/search"
+}
+
+// Client returns an NHL client using the server, with retries disabled.
+// opts are applied last so they can override the defaults.
+func (s *Server) Client(opts ...nhl.Option) *nhl.Client {
+	defaults := []nhl.Option{
+		nhl.WithBaseURL(s.WebURL()),
+		nhl.WithForgeBaseURL(s.ForgeURL()),
+		nhl.WithStatsBaseURL(s.StatsURL()),
+		nhl.WithSearchBaseURL(s.SearchURL()),
+		nhl.WithHTTPClient(s.Server.Client()),
+		nhl.WithRetryPolicy(nhl.NoRetry),
+	}
+	return nhl.NewClient(append(defaults, opts...)...)
+}
+
+// SetResponse serves body, marshaled to JSON unless it is already bytes or
+// a string, for requests to the endpoint path, e.g. "score/2024-03-01"
+func (s *Server) SetResponse(path string, body any) {
+	var data []byte
+	switch b := body.(type) {
+	case []byte:
+		data = b
+	case string:
+		data = []byte(b)
+	default:
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			panic(fmt.Sprintf("nhltest: cannot marshal response for %s: %v", path, err))
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[strings.Trim(path, "/")] = data
+}
+
+// Fail makes requests to endpoints under route fail with status. A route
+// is a path prefix such as "standings" or "gamecenter/2024020750". When
+// times is positive only that many requests fail.
+func (s *Server) Fail(route string, status, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[strings.Trim(route, "/")] = &failure{status: status, remaining: times}
+}
+
+// Delay holds responses for endpoints under route for d, or until the
+// request is canceled
+func (s *Server) Delay(route string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delays[strings.Trim(route, "/")] = d
+}
+
+// Script plays gameID through steps. The game starts at the first step and
+// moves on with each call to Advance.
+func (s *Server) Script(gameID int, steps ...GameStep) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scripts[gameID] = &script{steps: steps}
+}
+
+// Advance moves a scripted game to its next step and returns it. The last
+// step repeats once reached.
+func (s *Server) Advance(gameID int) GameStep {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc, ok := s.scripts[gameID]
+	if !ok {
+		panic(fmt.Sprintf("nhltest: game %d is not scripted", gameID))
+	}
+	if sc.index < len(sc.steps)-1 {
+		sc.index++
+	}
+	return sc.steps[sc.index]
+}
+
+// Requests returns the endpoint paths requested so far, with query strings
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// RequestCount returns how many requests were made to endpoints under route
+func (s *Server) RequestCount(route string) int {
+	route = strings.Trim(route, "/")
+	count := 0
+	for _, request := range s.Requests() {
+		if underRoute(strings.SplitN(request, "?", 2)[0], route) {
+			count++
+		}
+	}
+	return count
+}
+
+// serveHTTP dispatches a request to the fake web, content, stats or search API
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	api, path, _ := strings.Cut(path, "/")
+
+	request := path
+	if r.URL.RawQuery != "" {
+		request += "?" + r.URL.RawQuery
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	status := s.failure(path)
+	delay := s.delay(path)
+	override, overridden := s.responses[path]
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if status != 0 {
+		writeError(w, status)
+		return
+	}
+
+	body := override
+	if !overridden {
+		var err error
+		switch api {
+		case "v1":
+			body, err = s.web(path, r.URL.Query())
+		case "v2":
+			body, err = s.forge(path, r.URL.Query().Get("tags.slug"))
+		case "stats":
+			body, err = s.stats(path, r.URL.Query())
+		case "search":
+			body, err = s.search(path, r.URL.Query())
+		default:
+			err = errNotFound
+		}
+		if err == errNotFound {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// failure returns the status a request to path should fail with, or 0.
+// Callers hold s.mu.
+func (s *Server) failure(path string) int {
+	for route, f := range s.failures {
+		if !underRoute(path, route) {
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				delete(s.failures, route)
+			}
+		}
+		return f.status
+	}
+	return 0
+}
+
+// delay returns how long to hold a request to path. Callers hold s.mu.
+func (s *Server) delay(path string) time.Duration {
+	for route, d := range s.delays {
+		if underRoute(path, route) {
+			return d
+		}
+	}
+	return 0
+}
+
+// underRoute reports whether path is route or below it
+func underRoute(path, route string) bool {
+	return path == route || strings.HasPrefix(path, route+"/")
+}
+
+// errNotFound makes an endpoint respond with 404
+var errNotFound = errors.New("not found")
+
+var (
+	scorePath       = regexp.MustCompile(`^score/(\d{4}-\d{2}-\d{2})$`)
+	standingsPath   = regexp.MustCompile(`^standings/(now|\d{4}-\d{2}-\d{2})$`)
+	gamecenterPath  = regexp.MustCompile(`^gamecenter/(\d+)/(landing|boxscore|play-by-play|right-rail)$`)
+	gameStoryPath   = regexp.MustCompile(`^wsc/game-story/(\d+)$`)
+	rosterPath      = regexp.MustCompile(`^roster/([A-Z]{3})/(current|\d{8})$`)
+	rosterSeasons   = regexp.MustCompile(`^roster-season/([A-Z]{3})$`)
+	playerPath      = regexp.MustCompile(`^player/(\d+)/(landing|stats/\d+)$`)
+	gameLogPath     = regexp.MustCompile(`^player/(\d+)/game-log/(\d+)/(\d+)$`)
+	leadersPath     = regexp.MustCompile(`^(skater|goalie)-stats-leaders/\d+/\d+$`)
+	clubSchedule    = regexp.MustCompile(`^club-schedule-season/([A-Z]{3})/\d+$`)
+	clubStatsPath   = regexp.MustCompile(`^club-stats/([A-Z]{3})/(\d+)/(\d+)$`)
+	clubSeasonsPath = regexp.MustCompile(`^club-stats-season/([A-Z]{3})$`)
+	bracketPath     = regexp.MustCompile(`^playoff-bracket/(\d+)$`)
+	seriesPath      = regexp.MustCompile(`^schedule/playoff-series/(\d+)/([a-z])$`)
+	schedulePath    = regexp.MustCompile(`^schedule/(\d{4}-\d{2}-\d{2})$`)
+	draftPicksPath  = regexp.MustCompile(`^draft/picks/(\d+)/(all|\d+)$`)
+	rankingsPath    = regexp.MustCompile(`^draft/rankings/(\d+)/(\d)$`)
+	tvSchedulePath  = regexp.MustCompile(`^network/tv-schedule/(now|\d{4}-\d{2}-\d{2})$`)
+	videosPath      = "content/en-us/videos"
+	gameSlugPattern = regexp.MustCompile(`^gameid-(\d+)$`)
+)
+
+// web serves the web API at path
+func (s *Server) web(path string, query url.Values) ([]byte, error) {
+	if m := scorePath.FindStringSubmatch(path); m != nil {
+		if m[1] != FixtureDate {
+			return json.Marshal(map[string]any{"currentDate": m[1], "games": []any{}})
+		}
+		return s.fixture("score.json")
+	}
+	if path == "scoreboard/now" {
+		return s.fixture("scoreboard-now.json")
+	}
+	if standingsPath.MatchString(path) {
+		return s.fixture("standings.json")
+	}
+	if m := gamecenterPath.FindStringSubmatch(path); m != nil {
+		if m[1] != strconv.Itoa(FixtureGameID) {
+			return nil, errNotFound
+		}
+		return s.fixture("gamecenter-" + m[2] + ".json")
+	}
+	if m := gameStoryPath.FindStringSubmatch(path); m != nil {
+		if m[1] != strconv.Itoa(FixtureGameID) {
+			return nil, errNotFound
+		}
+		return s.fixture("wsc-game-story.json")
+	}
+	if m := rosterPath.FindStringSubmatch(path); m != nil {
+		name := "roster-" + m[1] + ".json"
+		if m[2] != "current" && m[2] != strconv.Itoa(FixtureSeason) {
+			name = "roster-" + m[1] + "-" + m[2] + ".json"
+		}
+		body, err := s.fixture(name)
+		if err == errNotFound {
+			return json.Marshal(nhl.RosterResponse{Forwards: []nhl.PlayerInfo{}, Defensemen: []nhl.PlayerInfo{}, Goalies: []nhl.PlayerInfo{}})
+		}
+		return body, err
+	}
+	if m := rosterSeasons.FindStringSubmatch(path); m != nil {
+		return rosterSeasonList(m[1])
+	}
+	if m := playerPath.FindStringSubmatch(path); m != nil {
+		body, err := s.fixture("player-" + m[1] + "-landing.json")
+		if err != nil || m[2] == "landing" {
+			return body, err
+		}
+		return json.Marshal(nhl.StatsResponse{Data: []any{}})
+	}
+	if m := gameLogPath.FindStringSubmatch(path); m != nil {
+		body, err := s.fixture("player-" + m[1] + "-game-log.json")
+		if err != nil || (m[2] == strconv.Itoa(FixtureSeason) && m[3] == "2") {
+			return body, err
+		}
+		return json.Marshal(map[string]any{"gameLog": []any{}})
+	}
+	if m := leadersPath.FindStringSubmatch(path); m != nil {
+		return s.leaders(m[1], query)
+	}
+	if m := clubSchedule.FindStringSubmatch(path); m != nil {
+		return s.clubSchedule(m[1])
+	}
+	if m := clubStatsPath.FindStringSubmatch(path); m != nil {
+		body, err := s.fixture("club-stats-" + m[1] + ".json")
+		if err == nil && m[2] == strconv.Itoa(FixtureSeason) && m[3] == "2" {
+			return body, nil
+		}
+		if err != nil && err != errNotFound {
+			return nil, err
+		}
+		gameType, _ := strconv.Atoi(m[3])
+		return json.Marshal(nhl.ClubStats{Season: m[2], GameType: nhl.GameType(gameType), Skaters: []nhl.ClubSkaterStats{}, Goalies: []nhl.ClubGoalieStats{}})
+	}
+	if m := bracketPath.FindStringSubmatch(path); m != nil {
+		return s.fixture("playoff-bracket-" + m[1] + ".json")
+	}
+	if m := seriesPath.FindStringSubmatch(path); m != nil {
+		return s.fixture("playoff-series-" + m[1] + "-" + m[2] + ".json")
+	}
+	if m := schedulePath.FindStringSubmatch(path); m != nil {
+		return s.scheduleWeek(m[1])
+	}
+	if m := draftPicksPath.FindStringSubmatch(path); m != nil {
+		return s.draftPicks(m[1], m[2])
+	}
+	if m := rankingsPath.FindStringSubmatch(path); m != nil {
+		return s.fixture("draft-rankings-" + m[1] + "-" + m[2] + ".json")
+	}
+	if m := tvSchedulePath.FindStringSubmatch(path); m != nil {
+		if m[1] != "now" && m[1] != FixtureDate {
+			return json.Marshal(nhl.TVSchedule{Date: m[1], StartDate: m[1], EndDate: m[1], Broadcasts: []nhl.NetworkBroadcast{}})
+		}
+		return s.fixture("network-tv-schedule.json")
+	}
+	if path == "where-to-watch" {
+		return s.fixture("where-to-watch.json")
+	}
+	if m := clubSeasonsPath.FindStringSubmatch(path); m != nil {
+		body, err := s.fixture("club-stats-season-" + m[1] + ".json")
+		if err == errNotFound {
+			return json.Marshal([]nhl.ClubStatsSeason{})
+		}
+		return body, err
+	}
+	return nil, errNotFound
+}
+
+// forge serves the content API at path
+func (s *Server) forge(path, slug string) ([]byte, error) {
+	if path != videosPath {
+		return nil, errNotFound
+	}
+	if m := gameSlugPattern.FindStringSubmatch(slug); m != nil && m[1] == strconv.Itoa(FixtureGameID) {
+		return s.fixture("videos.json")
+	}
+	return json.Marshal(map[string]any{"items": []any{}})
+}
+
+// stats serves the stats REST API at path
+func (s *Server) stats(path string, query url.Values) ([]byte, error) {
+	switch path {
+	case "team", "franchise":
+		return s.fixture("stats-" + path + ".json")
+	case "shiftcharts":
+		if query.Get("cayenneExp") != "gameId="+strconv.Itoa(FixtureGameID) {
+			return json.Marshal(map[string]any{"data": []any{}, "total": 0})
+		}
+		return s.fixture("shiftcharts.json")
+	case "skater/summary", "goalie/summary":
+		return s.statsPage("stats-"+strings.Replace(path, "/", "-", 1)+".json", query)
+	}
+	return nil, errNotFound
+}
+
+// statsPage serves the page of a stats REST report fixture selected by the
+// start and limit parameters, keeping the total of every page
+func (s *Server) statsPage(name string, query url.Values) ([]byte, error) {
+	body, err := s.fixture(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var report struct {
+		Data  []json.RawMessage `json:"data"`
+		Total int               `json:"total"`
+	}
+	if err := json.Unmarshal(body, &report); err != nil {
+		return nil, err
+	}
+	start, _ := strconv.Atoi(query.Get("start"))
+	start = min(max(start, 0), len(report.Data))
+	end := len(report.Data)
+	if limit, _ := strconv.Atoi(query.Get("limit")); limit > 0 {
+		end = min(start+limit, end)
+	}
+	report.Data = report.Data[start:end]
+	return json.Marshal(report)
+}
+
+// search serves the player search API at path, matching the q parameter
+// against player names regardless of case and accents
+func (s *Server) search(path string, query url.Values) ([]byte, error) {
+	if path != "search/player" {
+		return nil, errNotFound
+	}
+	body, err := s.fixture("search-player.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var players []map[string]any
+	if err := json.Unmarshal(body, &players); err != nil {
+		return nil, err
+	}
+	q := plain(query.Get("q"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	found := []map[string]any{}
+	for _, player := range players {
+		name, _ := player["name"].(string)
+		if !strings.Contains(plain(name), q) {
+			continue
+		}
+		if active, _ := player["active"].(bool); query.Get("active") == "true" && !active {
+			continue
+		}
+		if limit > 0 && len(found) == limit {
+			break
+		}
+		found = append(found, player)
+	}
+	return json.Marshal(found)
+}
+
+// leaders serves the kind of stats leaders fixture, keeping the requested
+// categories and at most limit players in each
+func (s *Server) leaders(kind string, query url.Values) ([]byte, error) {
+	body, err := s.fixture(kind + "-stats-leaders.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var leaders map[string][]json.RawMessage
+	if err := json.Unmarshal(body, &leaders); err != nil {
+		return nil, err
+	}
+	if categories := query.Get("categories"); categories != "" {
+		requested := make(map[string][]json.RawMessage)
+		for _, category := range strings.Split(categories, ",") {
+			if players, ok := leaders[category]; ok {
+				requested[category] = players
+			}
+		}
+		leaders = requested
+	}
+	if limit, _ := strconv.Atoi(query.Get("limit")); limit > 0 {
+		for category, players := range leaders {
+			leaders[category] = players[:min(limit, len(players))]
+		}
+	}
+	return json.Marshal(leaders)
+}
+
+// rosterSeasonList lists the seasons team has roster fixtures for, with
+// the current roster standing for FixtureSeason
+func rosterSeasonList(team string) ([]byte, error) {
+	seasons := []int{}
+	names, err := fs.Glob(fixtures, "fixtures/roster-"+team+"*.json")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		season := strings.TrimSuffix(strings.TrimPrefix(name, "fixtures/roster-"+team), ".json")
+		if season == "" {
+			seasons = append(seasons, FixtureSeason)
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(season, "-"))
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, id)
+	}
+	return json.Marshal(seasons)
+}
+
+// draftPicks serves the draft fixture for year, keeping only the picks made
+// in round unless it is all
+func (s *Server) draftPicks(year, round string) ([]byte, error) {
+	body, err := s.fixture("draft-picks-" + year + ".json")
+	if err != nil || round == "all" {
+		return body, err
+	}
+
+	var draft nhl.DraftPicks
+	if err := json.Unmarshal(body, &draft); err != nil {
+		return nil, err
+	}
+	picks := []nhl.DraftPick{}
+	for _, pick := range draft.Picks {
+		if strconv.Itoa(pick.Round) == round {
+			picks = append(picks, pick)
+		}
+	}
+	draft.Picks = picks
+	return json.Marshal(draft)
+}
+
+// plain lowercases s and strips the accents used in the search fixture
+func plain(s string) string {
+	return accents.Replace(strings.ToLower(s))
+}
+
+var accents = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ý", "y", "ü", "u", "š", "s", "ž", "z", "č", "c", "ř", "r", "ä", "a", "ö", "o")
+
+// clubSchedule serves the fixture schedule games involving team
+func (s *Server) clubSchedule(team string) ([]byte, error) {
+	body, err := s.fixture("club-schedule-season.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule struct {
+		Games []map[string]any `json:"games"`
+	}
+	if err := json.Unmarshal(body, &schedule); err != nil {
+		return nil, err
+	}
+	games := []map[string]any{}
+	for _, game := range schedule.Games {
+		if abbrev(game["awayTeam"]) == team || abbrev(game["homeTeam"]) == team {
+			games = append(games, game)
+		}
+	}
+	return json.Marshal(map[string]any{"games": games})
+}
+
+// scheduleWeek serves the week of the league schedule starting at date,
+// built from the fixture season schedule's games
+func (s *Server) scheduleWeek(date string) ([]byte, error) {
+	start, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, errNotFound
+	}
+	body, err := s.fixture("club-schedule-season.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule struct {
+		Games []map[string]any `json:"games"`
+	}
+	if err := json.Unmarshal(body, &schedule); err != nil {
+		return nil, err
+	}
+	week := make([]map[string]any, 7)
+	for i := range week {
+		day := start.AddDate(0, 0, i).Format("2006-01-02")
+		games := []map[string]any{}
+		for _, game := range schedule.Games {
+			if game["gameDate"] == day {
+				games = append(games, game)
+			}
+		}
+		week[i] = map[string]any{"date": day, "numberOfGames": len(games), "games": games}
+	}
+	return json.Marshal(map[string]any{
+		"nextStartDate":     start.AddDate(0, 0, 7).Format("2006-01-02"),
+		"previousStartDate": start.AddDate(0, 0, -7).Format("2006-01-02"),
+		"gameWeek":          week,
+	})
+}
+
+// abbrev returns the abbrev field of a decoded team object
+func abbrev(team any) string {
+	m, _ := team.(map[string]any)
+	s, _ := m["abbrev"].(string)
+	return s
+}
+
+// fixture returns an embedded fixture with scripted games applied
+func (s *Server) fixture(name string) ([]byte, error) {
+	body, err := fixtures.ReadFile("fixtures/" + name)
+	if err != nil {
+		return nil, errNotFound
+	}
+
+	s.mu.Lock()
+	steps := make(map[int]GameStep, len(s.scripts))
+	for gameID, sc := range s.scripts {
+		steps[gameID] = sc.steps[sc.index]
+	}
+	s.mu.Unlock()
+	if len(steps) == 0 {
+		return body, nil
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, err
+	}
+	applySteps(v, steps)
+	return json.Marshal(v)
+}
+
+// applySteps rewrites every game object in v that has a scripted step
+func applySteps(v any, steps map[int]GameStep) {
+	switch v := v.(type) {
+	case map[string]any:
+		if id, ok := v["id"].(float64); ok {
+			if step, ok := steps[int(id)]; ok {
+				if _, isGame := v["gameState"]; isGame {
+					applyStep(v, step)
+				}
+			}
+		}
+		for _, child := range v {
+			applySteps(child, steps)
+		}
+	case []any:
+		for _, child := range v {
+			applySteps(child, steps)
+		}
+	}
+}
+
+// applyStep sets the state, period, clock and score of a game object
+func applyStep(game map[string]any, step GameStep) {
+	game["gameState"] = step.State
+	if _, ok := game["period"]; ok {
+		game["period"] = step.Period
+	}
+	if pd, ok := game["periodDescriptor"].(map[string]any); ok {
+		pd["number"] = step.Period
+	}
+	if clock, ok := game["clock"].(map[string]any); ok {
+		clock["timeRemaining"] = step.TimeRemaining
+		clock["secondsRemaining"] = seconds(step.TimeRemaining)
+		clock["running"] = step.State.IsLive() && !step.InIntermission
+		clock["inIntermission"] = step.InIntermission
+	}
+	if team, ok := game["awayTeam"].(map[string]any); ok {
+		team["score"] = step.AwayScore
+	}
+	if team, ok := game["homeTeam"].(map[string]any); ok {
+		team["score"] = step.HomeScore
+	}
+}
+
+// seconds converts a MM:SS clock to seconds
+func seconds(clock string) int {
+	mins, sec, ok := strings.Cut(clock, ":")
+	if !ok {
+		return 0
+	}
+	m, _ := strconv.Atoi(mins)
+	s, _ := strconv.Atoi(sec)
+	return m*60 + s
+}
+
+// writeError writes an error response like the NHL API's
+func writeError(w http.ResponseWriter, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{"status": status, "message": http.StatusText(status)})
+}
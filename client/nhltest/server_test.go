@@ -0,0 +1,430 @@
+package nhltest
+
+import (
+	"context"
+	"errors"
+	nhl "go-nhl/client"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServerEndpoints(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	schedule, err := client.GetScheduleByDate(ctx, FixtureDate, nhl.SortByDateAsc)
+	if err != nil {
+		t.Fatalf("GetScheduleByDate() error = %v", err)
+	}
+	if len(schedule.Games) != 2 {
+		t.Errorf("GetScheduleByDate() returned %d games, want 2", len(schedule.Games))
+	}
+
+	empty, err := client.GetScheduleByDate(ctx, "2024-07-01", nhl.SortByDateAsc)
+	if err != nil || len(empty.Games) != 0 {
+		t.Errorf("GetScheduleByDate(offseason) = %v, %v, want no games", empty, err)
+	}
+
+	if _, err := client.GetLiveGameUpdates(ctx); err != nil {
+		t.Errorf("GetLiveGameUpdates() error = %v", err)
+	}
+
+	standings, err := client.GetStandings(ctx)
+	if err != nil || len(standings.Standings) == 0 {
+		t.Errorf("GetStandings() = %v, %v", standings, err)
+	}
+
+	details, err := client.GetGameDetails(ctx, FixtureGameID)
+	if err != nil {
+		t.Fatalf("GetGameDetails() error = %v", err)
+	}
+	if details.AwayTeam.Abbrev != "NYR" || details.HomeTeam.Abbrev != "CHI" {
+		t.Errorf("GetGameDetails() teams = %s at %s, want NYR at CHI", details.AwayTeam.Abbrev, details.HomeTeam.Abbrev)
+	}
+
+	if _, err := client.GetGameBoxscore(ctx, FixtureGameID); err != nil {
+		t.Errorf("GetGameBoxscore() error = %v", err)
+	}
+	plays, err := client.GetGamePlayByPlay(ctx, FixtureGameID)
+	if err != nil || len(plays.Plays) == 0 || len(plays.RosterSpots) == 0 {
+		t.Errorf("GetGamePlayByPlay() = %v, %v", plays, err)
+	}
+	if _, err := client.GetGameDetails(ctx, 2024020001); !errors.Is(err, nhl.ErrNotFound) {
+		t.Errorf("GetGameDetails(unknown) error = %v, want ErrNotFound", err)
+	}
+
+	players, err := client.SearchPlayer(ctx, "heiskanen")
+	if err != nil || len(players) != 1 || players[0].PlayerID != FixturePlayer {
+		t.Errorf("SearchPlayer() = %v, %v, want Heiskanen", players, err)
+	}
+	seasons, err := client.GetFilteredPlayerStats(ctx, FixturePlayer, nil)
+	if err != nil || len(seasons) == 0 {
+		t.Errorf("GetFilteredPlayerStats() = %v, %v", seasons, err)
+	}
+
+	gameLog, err := client.GetPlayerGameLog(ctx, FixturePlayer, FixtureSeason, nhl.GameTypeRegularSeason)
+	if err != nil || len(gameLog.Skaters) == 0 {
+		t.Errorf("GetPlayerGameLog() = %v, %v", gameLog, err)
+	}
+
+	leaders, err := client.GetStatsLeaders(ctx, FixtureSeason)
+	if err != nil || len(leaders.Points) == 0 {
+		t.Errorf("GetStatsLeaders() = %v, %v", leaders, err)
+	}
+
+	goalies, err := client.GetLeaders(ctx, nhl.LeadersQuery{
+		SeasonID:   FixtureSeason,
+		Categories: []nhl.LeaderCategory{nhl.LeaderPoints, nhl.LeaderSavePctg},
+		Limit:      2,
+	})
+	if err != nil || len(goalies) != 2 || len(goalies[nhl.LeaderPoints]) != 2 || len(goalies[nhl.LeaderSavePctg]) != 2 {
+		t.Errorf("GetLeaders() = %v, %v, want two points and two save percentage leaders", goalies, err)
+	}
+
+	clubStats, err := client.GetClubStats(ctx, "NYR", FixtureSeason, nhl.GameTypeRegularSeason)
+	if err != nil || len(clubStats.Skaters) == 0 || len(clubStats.Goalies) == 0 {
+		t.Errorf("GetClubStats() = %v, %v", clubStats, err)
+	}
+	clubSeasons, err := client.GetClubStatsSeasons(ctx, "NYR")
+	if err != nil || len(clubSeasons) == 0 {
+		t.Errorf("GetClubStatsSeasons() = %v, %v", clubSeasons, err)
+	}
+
+	bracket, err := client.GetPlayoffBracket(ctx, FixtureSeason%10000)
+	if err != nil || len(bracket.Series) != 15 || bracket.Series[14].Winner() == nil || bracket.Series[14].Winner().Abbrev != "FLA" {
+		t.Errorf("GetPlayoffBracket() = %v, %v, want FLA winning the final", bracket, err)
+	}
+	series, err := client.GetPlayoffSeries(ctx, FixtureSeason, "A")
+	if err != nil || series.State() != nhl.SeriesComplete || len(series.GameIDs()) != 7 {
+		t.Errorf("GetPlayoffSeries() = %v, %v, want a finished series of 7 scheduled games", series, err)
+	}
+
+	bruins, err := client.GetTeamRosterForSeason(ctx, "BOS", 20102011)
+	if err != nil || len(bruins.Goalies) != 2 || bruins.Goalies[0].LastName.Default != "Thomas" {
+		t.Errorf("GetTeamRosterForSeason() = %v, %v, want the 2010-11 Bruins", bruins, err)
+	}
+	rosterSeasons, err := client.GetRosterSeasons(ctx, "BOS")
+	if err != nil || len(rosterSeasons) != 1 || rosterSeasons[0] != 20102011 {
+		t.Errorf("GetRosterSeasons() = %v, %v, want [20102011]", rosterSeasons, err)
+	}
+
+	draft, err := client.GetDraftPicks(ctx, 2015, 1)
+	if err != nil || len(draft.Picks) != 6 || draft.Overall(1) == nil {
+		t.Errorf("GetDraftPicks() = %v, %v, want the first round of 2015", draft, err)
+	}
+	rankings, err := client.GetDraftRankings(ctx, 2024, nhl.DraftRankingNorthAmericanSkaters)
+	if err != nil || len(rankings.Rankings) == 0 {
+		t.Errorf("GetDraftRankings() = %v, %v", rankings, err)
+	}
+	drafted, err := client.GetPlayerDraftDetails(ctx, FixturePlayer)
+	if err != nil || drafted == nil || drafted.OverallPick != 3 {
+		t.Errorf("GetPlayerDraftDetails() = %v, %v, want 3rd overall", drafted, err)
+	}
+	undrafted, err := client.GetPlayerDraftDetails(ctx, FixtureScorer)
+	if err != nil || undrafted != nil {
+		t.Errorf("GetPlayerDraftDetails() for an undrafted player = %v, %v, want nil", undrafted, err)
+	}
+
+	week, err := client.GetLeagueSchedule(ctx, "2024-02-05", "2024-02-18", &nhl.ScheduleFilter{Teams: []string{"CHI"}, HomeAway: nhl.HomeOnly})
+	if err != nil || len(week.GameDays) != 1 || week.GameDays[0].Date != FixtureDate || len(week.Games()) != 1 || week.Games()[0].ID != FixtureGameID {
+		t.Errorf("GetLeagueSchedule() = %v, %v, want FixtureGameID alone", week, err)
+	}
+
+	shifts, err := client.GetGameShifts(ctx, FixtureGameID)
+	if err != nil || len(shifts.Goals()) != 2 {
+		t.Fatalf("GetGameShifts() = %v, %v, want a chart with two goals", shifts, err)
+	}
+	pbp, err := client.GetGamePlayByPlay(ctx, FixtureGameID)
+	if err != nil {
+		t.Fatalf("GetGamePlayByPlay() error = %v", err)
+	}
+	// The Rangers' four goals and the Blackhawks' power play goal, by the
+	// Rangers' strength
+	goals := pbp.StrengthCounts(pbp.AwayTeam.ID, "goal")
+	if goals[nhl.StrengthEven] != 2 || goals[nhl.StrengthPowerPlay] != 1 || goals[nhl.StrengthShortHanded] != 1 || goals[nhl.StrengthEmptyNet] != 1 {
+		t.Errorf("StrengthCounts(NYR, goal) = %v", goals)
+	}
+	// Panarin scored two goals and assisted on two more
+	panarin := pbp.FindPlayers("panarin")
+	if len(panarin) != 1 || panarin[0].PlayerID != FixtureScorer {
+		t.Fatalf("FindPlayers(panarin) = %v, want FixtureScorer", panarin)
+	}
+	panarinGoals := pbp.FilterPlays(&nhl.PlayFilter{PlayerIDs: []int{FixtureScorer}, Types: []nhl.EventType{nhl.EventGoal}})
+	scored := 0
+	for _, play := range panarinGoals {
+		if goal, ok := play.Goal(); ok && goal.Scorer.PlayerID == FixtureScorer {
+			scored++
+		}
+	}
+	if len(panarinGoals) != 4 || scored != 2 {
+		t.Errorf("FilterPlays(Panarin goals) = %d plays with %d scored, want 4 with 2", len(panarinGoals), scored)
+	}
+	for _, eventType := range []nhl.EventType{nhl.EventGiveaway, nhl.EventTakeaway, nhl.EventMissedShot, nhl.EventDelayedPenalty} {
+		if plays := pbp.FilterPlays(&nhl.PlayFilter{Types: []nhl.EventType{eventType}}); len(plays) != 1 {
+			t.Errorf("FilterPlays(%s) = %d plays, want 1", eventType, len(plays))
+		}
+	}
+	for _, play := range shifts.OnIceForPlays(pbp) {
+		// Panarin's first period goal with Zibanejad and Fox on, against
+		// Jones and the goalie
+		if play.Play.EventID == 5 && (len(play.Away) != 4 || len(play.Home) != 2) {
+			t.Errorf("OnIceForPlays() goal = %+v, want 4 Rangers and 2 Blackhawks", play)
+		}
+	}
+
+	skaters, err := client.Stats().SkaterSummary(ctx, &nhl.StatsQuery{Cayenne: nhl.SeasonIs(FixtureSeason), Sort: []nhl.StatsSort{nhl.Desc("points")}, Limit: 4})
+	if err != nil || len(skaters) != 6 || skaters[0].PlayerID != FixtureScorer {
+		t.Errorf("SkaterSummary() = %v, %v, want 6 skaters led by FixtureScorer", skaters, err)
+	}
+	goaliePage, err := client.Stats().Goalies(ctx, nhl.GoalieReportSummary, &nhl.StatsQuery{Limit: 2})
+	if err != nil || len(goaliePage.Data) != 2 || goaliePage.Total != 3 {
+		t.Errorf("Goalies() = %v, %v, want the first 2 of 3 goalies", goaliePage, err)
+	}
+
+	rail, err := client.GetGameRightRail(ctx, FixtureGameID)
+	if err != nil || len(rail.SeasonSeries) != 2 || rail.Linescore.Totals.Away != details.AwayTeam.Score {
+		t.Errorf("GetGameRightRail() = %v, %v, want two series games and the game's score", rail, err)
+	}
+
+	tv, err := client.GetTVSchedule(ctx, FixtureDate)
+	if err != nil || len(tv.Broadcasts) == 0 {
+		t.Errorf("GetTVSchedule() = %v, %v, want the fixture programs", tv, err)
+	}
+	services, err := client.GetWhereToWatch(ctx, "CA")
+	if err != nil || len(services) != 1 {
+		t.Errorf("GetWhereToWatch(CA) = %v, %v, want one service", services, err)
+	}
+
+	team, err := client.GetTeamByIdentifier(ctx, "NYR")
+	if err != nil {
+		t.Fatalf("GetTeamByIdentifier() error = %v", err)
+	}
+	broadcasts, err := client.GetTeamBroadcasts(ctx, team, FixtureSeason, &nhl.BroadcastFilter{Markets: []string{nhl.MarketNational}})
+	if err != nil || len(broadcasts.Games) != 2 {
+		t.Errorf("GetTeamBroadcasts(NYR, national) = %v, %v, want two games", broadcasts, err)
+	}
+	teamSchedule, err := client.GetTeamSchedule(ctx, team, FixtureSeason)
+	if err != nil {
+		t.Fatalf("GetTeamSchedule() error = %v", err)
+	}
+	for _, game := range teamSchedule.Games {
+		if game.AwayTeam.Abbreviation != "NYR" && game.HomeTeam.Abbreviation != "NYR" {
+			t.Errorf("GetTeamSchedule(NYR) returned %s at %s", game.AwayTeam.Abbreviation, game.HomeTeam.Abbreviation)
+		}
+	}
+
+	highlights, err := client.GetGameHighlights(ctx, FixtureGameID)
+	if err != nil || len(highlights.Items) == 0 {
+		t.Errorf("GetGameHighlights() = %v, %v", highlights, err)
+	}
+}
+
+func TestServerProgression(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Script(FixtureGameID, Progression...)
+	client := server.Client()
+	ctx := context.Background()
+
+	var states []nhl.GameState
+	for i := 0; i < len(Progression); i++ {
+		if i > 0 {
+			server.Advance(FixtureGameID)
+		}
+		details, err := client.GetGameDetails(ctx, FixtureGameID)
+		if err != nil {
+			t.Fatalf("GetGameDetails() error = %v", err)
+		}
+		want := Progression[i]
+		if details.AwayTeam.Score != want.AwayScore || details.HomeTeam.Score != want.HomeScore {
+			t.Errorf("step %d score = %d-%d, want %d-%d", i, details.AwayTeam.Score, details.HomeTeam.Score, want.AwayScore, want.HomeScore)
+		}
+		states = append(states, details.GameState)
+	}
+
+	want := []nhl.GameState{nhl.GameStatePregame, nhl.GameStateLive, nhl.GameStateLive, nhl.GameStateCritical, nhl.GameStateFinal}
+	for i := range want {
+		if states[i] != want[i] {
+			t.Errorf("states = %v, want %v", states, want)
+			break
+		}
+	}
+
+	// The same game in the daily scores follows the script too
+	schedule, err := client.GetScheduleByDate(ctx, FixtureDate, nhl.SortByDateAsc)
+	if err != nil {
+		t.Fatalf("GetScheduleByDate() error = %v", err)
+	}
+	for _, game := range schedule.Games {
+		if game.ID == FixtureGameID && game.GameState != "FINAL" {
+			t.Errorf("scores gameState = %s, want FINAL", game.GameState)
+		}
+	}
+}
+
+func TestServerFailures(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	server.Fail("standings", http.StatusServiceUnavailable, 1)
+	client := server.Client()
+	if _, err := client.GetStandings(ctx); !errors.Is(err, nhl.ErrUnavailable) {
+		t.Errorf("GetStandings() error = %v, want ErrUnavailable", err)
+	}
+	if _, err := client.GetStandings(ctx); err != nil {
+		t.Errorf("GetStandings() after the failure error = %v", err)
+	}
+
+	// A retrying client recovers from a transient failure
+	server.Fail("standings/now", http.StatusTooManyRequests, 1)
+	retrying := server.Client(nhl.WithRetryPolicy(nhl.RetryPolicy{
+		MaxAttempts:     2,
+		InitialBackoff:  time.Millisecond,
+		RetryableStatus: []int{http.StatusTooManyRequests},
+	}))
+	before := server.RequestCount("standings/now")
+	if _, err := retrying.GetStandings(ctx); err != nil {
+		t.Errorf("GetStandings() with retries error = %v", err)
+	}
+	if got := server.RequestCount("standings/now") - before; got != 2 {
+		t.Errorf("made %d requests, want 2", got)
+	}
+}
+
+func TestServerDelay(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Delay("scoreboard", time.Second)
+
+	client := server.Client(nhl.WithTimeout(20 * time.Millisecond))
+	_, err := client.GetLiveGameUpdates(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetLiveGameUpdates() error = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestServerTeams(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	client := server.Client()
+	teams, err := client.GetTeams(ctx)
+	if err != nil {
+		t.Fatalf("GetTeams() error = %v", err)
+	}
+	if len(teams.Teams) != 6 {
+		t.Errorf("GetTeams() returned %d teams, want the 6 in the standings", len(teams.Teams))
+	}
+
+	dallas, err := client.GetTeamByIdentifier(ctx, "DAL")
+	if err != nil {
+		t.Fatalf("GetTeamByIdentifier(DAL) error = %v", err)
+	}
+	want := nhl.TeamInfo{
+		ID:           25,
+		Name:         nhl.LanguageNames{Default: "Dallas Stars", Fr: "Dallas Stars"},
+		Abbreviation: "DAL",
+		City:         nhl.LanguageNames{Default: "Dallas"},
+		TriCode:      "DAL",
+		FranchiseID:  15,
+		Active:       true,
+		Conference:   "Western",
+		Division:     "Central",
+		Logo:         "https://assets.nhle.com/logos/nhl/svg/DAL_light.svg",
+	}
+	if *dallas != want {
+		t.Errorf("GetTeamByIdentifier(DAL) = %+v, want %+v", *dallas, want)
+	}
+
+	// Franchises that no longer play are found but not listed
+	arizona, err := client.GetTeamByIdentifier(ctx, "ARI")
+	if err != nil || arizona.ID != 53 || arizona.Active {
+		t.Errorf("GetTeamByIdentifier(ARI) = %+v, %v, want inactive team 53", arizona, err)
+	}
+	if _, err := client.GetTeamByIdentifier(ctx, "UTA"); !errors.Is(err, nhl.ErrNotFound) {
+		t.Errorf("GetTeamByIdentifier(UTA) error = %v, want ErrNotFound before Utah joined", err)
+	}
+
+	// The built-in table is used when the directory cannot be loaded
+	server.Fail("team", http.StatusInternalServerError, 0)
+	fallback := server.Client()
+	utah, err := fallback.GetTeamByIdentifier(ctx, "UTA")
+	if err != nil || !utah.Active || utah.Division != "Central" {
+		t.Errorf("GetTeamByIdentifier(UTA) from the built-in table = %+v, %v", utah, err)
+	}
+	if arizona, err := fallback.GetTeamByIdentifier(ctx, "ARI"); err != nil || arizona.Active {
+		t.Errorf("GetTeamByIdentifier(ARI) from the built-in table = %+v, %v, want inactive", arizona, err)
+	}
+}
+
+func TestServerTeamsTTL(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	client := server.Client(nhl.WithTeamsTTL(20 * time.Millisecond))
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetTeams(ctx); err != nil {
+			t.Fatalf("GetTeams() error = %v", err)
+		}
+	}
+	if got := server.RequestCount("team"); got != 1 {
+		t.Errorf("made %d team list requests before the TTL, want 1", got)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, err := client.GetTeams(ctx); err != nil {
+		t.Fatalf("GetTeams() after the TTL error = %v", err)
+	}
+	if got := server.RequestCount("team"); got != 2 {
+		t.Errorf("made %d team list requests after the TTL, want 2", got)
+	}
+}
+
+func TestServerPlayerSearch(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	tests := []struct {
+		query string
+		opts  nhl.PlayerSearchOptions
+		want  []int
+	}{
+		{query: "Stutzle", want: []int{8482116}},
+		{query: "slafkovsky", want: []int{8483515}},
+		{query: "Jagr", want: []int{8448208}},
+		{query: "Jagr", opts: nhl.PlayerSearchOptions{ActiveOnly: true}, want: nil},
+		{query: "shesterkn", want: []int{FixtureGoalie}},
+		{query: "Smyth", opts: nhl.PlayerSearchOptions{Team: "EDM"}, want: []int{8460626}},
+	}
+
+	for _, tt := range tests {
+		players, err := client.SearchPlayers(ctx, tt.query, tt.opts)
+		if err != nil {
+			t.Errorf("SearchPlayers(%q) error = %v", tt.query, err)
+			continue
+		}
+		var got []int
+		for _, player := range players {
+			got = append(got, player.PlayerID)
+		}
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Errorf("SearchPlayers(%q, %+v) = %v, want %v", tt.query, tt.opts, got, tt.want)
+		}
+	}
+
+	jagr, err := client.SearchPlayers(ctx, "Jaromír Jágr", nhl.PlayerSearchOptions{})
+	if err != nil || len(jagr) != 1 {
+		t.Fatalf("SearchPlayers(Jágr) = %v, %v", jagr, err)
+	}
+	if jagr[0].Active || jagr[0].LastTeamAbbrev != "CGY" || jagr[0].LastSeasonID != 20172018 {
+		t.Errorf("SearchPlayers(Jágr) = %+v, want retired after 2017-18 with CGY", jagr[0])
+	}
+}
@@ -0,0 +1,73 @@
+package nhl
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout is the per-request timeout used when none is configured
+const DefaultTimeout = 30 * time.Second
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL sets the base URL of the NHL web API (api-web.nhle.com)
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithForgeBaseURL sets the base URL of the NHL content API used for video highlights
+func WithForgeBaseURL(url string) Option {
+	return func(c *Client) {
+		c.forgeBaseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithStatsBaseURL sets the base URL of the NHL stats REST API (api.nhle.com/stats/rest)
+func WithStatsBaseURL(url string) Option {
+	return func(c *Client) {
+		c.statsBaseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithSearchBaseURL sets the base URL of the NHL player search API (search.d3.nhle.com)
+func WithSearchBaseURL(url string) Option {
+	return func(c *Client) {
+		c.searchBaseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to make requests
+func WithHTTPClient(httpClient HTTPClient) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the timeout applied to each request.
+// A zero or negative duration disables the timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithHeader adds a header sent with every request
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
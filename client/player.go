@@ -1,92 +1,17 @@
 package nhl
 
 import (
+	"context"
 	"fmt"
 	"sort"
-	"strings"
 )
 
-// SearchPlayer searches for players by name
-func (c *Client) SearchPlayer(name string) ([]PlayerSearchResult, error) {
-	if name == "" {
-		return nil, fmt.Errorf("name cannot be empty")
-	}
-
-	// Get all teams to search through rosters
-	teams, err := c.GetTeams()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get teams: %v", err)
-	}
-
-	var results []PlayerSearchResult
-	name = strings.ToLower(name)
-
-	// Search through each team's roster
-	for _, team := range teams.Teams {
-		roster, err := c.GetTeamRoster(team.Abbreviation)
-		if err != nil {
-			continue // Skip teams with errors
-		}
-
-		// Helper function to check if a player matches the search
-		matchesSearch := func(player PlayerInfo) bool {
-			fullName := strings.ToLower(player.FirstName.Default + " " + player.LastName.Default)
-			return strings.Contains(strings.ToLower(player.FirstName.Default), name) ||
-				strings.Contains(strings.ToLower(player.LastName.Default), name) ||
-				strings.Contains(fullName, name)
-		}
-
-		// Check forwards
-		for _, player := range roster.Forwards {
-			if matchesSearch(player) {
-				results = append(results, PlayerSearchResult{
-					FirstName:    player.FirstName,
-					LastName:     player.LastName,
-					Position:     player.Position,
-					JerseyNumber: player.JerseyNumber,
-					TeamID:       team.ID,
-					TeamAbbrev:   team.Abbreviation,
-					PlayerID:     player.ID,
-				})
-			}
-		}
-
-		// Check defensemen
-		for _, player := range roster.Defensemen {
-			if matchesSearch(player) {
-				results = append(results, PlayerSearchResult{
-					FirstName:    player.FirstName,
-					LastName:     player.LastName,
-					Position:     player.Position,
-					JerseyNumber: player.JerseyNumber,
-					TeamID:       team.ID,
-					TeamAbbrev:   team.Abbreviation,
-					PlayerID:     player.ID,
-				})
-			}
-		}
-
-		// Check goalies
-		for _, player := range roster.Goalies {
-			if matchesSearch(player) {
-				results = append(results, PlayerSearchResult{
-					FirstName:    player.FirstName,
-					LastName:     player.LastName,
-					Position:     player.Position,
-					JerseyNumber: player.JerseyNumber,
-					TeamID:       team.ID,
-					TeamAbbrev:   team.Abbreviation,
-					PlayerID:     player.ID,
-				})
-			}
-		}
-	}
-
-	return results, nil
-}
-
-// GetPlayerStats returns stats for a player
-func (c *Client) GetPlayerStats(playerID int, isGoalie bool, reportType string, filter *StatsFilter) (interface{}, error) {
+// GetPlayerStats returns stats for a player, for every season or for
+// filter's season. The web API returns regular season and playoff stats
+// together, so reportType, regularSeason or playoffs, is only checked and
+// filter's game type is not supported; reports by game type come from the
+// stats REST API, see StatsClient.
+func (c *Client) GetPlayerStats(ctx context.Context, playerID int, isGoalie bool, reportType string, filter *StatsFilter) (interface{}, error) {
 	if playerID <= 0 {
 		return nil, fmt.Errorf("invalid player ID: %d", playerID)
 	}
@@ -100,56 +25,55 @@ func (c *Client) GetPlayerStats(playerID int, isGoalie bool, reportType string,
 		return nil, fmt.Errorf("invalid report type: %s", reportType)
 	}
 
+	if filter != nil && filter.GameType != 0 {
+		return nil, fmt.Errorf("game type %d is not supported, use StatsClient for stats by game type", filter.GameType)
+	}
+
 	url := fmt.Sprintf("%s/player/%d/landing", c.baseURL, playerID)
-	if filter != nil {
-		if filter.SeasonID > 0 {
-			url = fmt.Sprintf("%s/player/%d/stats/%d", c.baseURL, playerID, filter.SeasonID)
-		}
-		if filter.GameType != 0 {
-			url = fmt.Sprintf("%s?cayenneExp=gameTypeId=%d", url, filter.GameType)
-		}
+	if filter != nil && filter.SeasonID > 0 {
+		url = fmt.Sprintf("%s/player/%d/stats/%d", c.baseURL, playerID, filter.SeasonID)
 	}
 
 	if isGoalie {
 		var response GoalieStatsResponse
-		err := c.get(url, &response)
+		err := c.get(ctx, url, &response)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get goalie stats: %v", err)
+			return nil, fmt.Errorf("failed to get goalie stats: %w", err)
 		}
 		return &response, nil
 	}
 
 	var response SkaterStatsResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get skater stats: %v", err)
+		return nil, fmt.Errorf("failed to get skater stats: %w", err)
 	}
 	return &response, nil
 }
 
 // GetPlayerSeasonStats returns a player's stats for all seasons
-func (c *Client) GetPlayerSeasonStats(playerID int) (*PlayerLandingResponse, error) {
+func (c *Client) GetPlayerSeasonStats(ctx context.Context, playerID int) (*PlayerLandingResponse, error) {
 	if playerID <= 0 {
 		return nil, fmt.Errorf("invalid player ID: %d", playerID)
 	}
 
 	url := fmt.Sprintf("%s/player/%d/landing", c.baseURL, playerID)
 	var response PlayerLandingResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get player season stats: %v", err)
+		return nil, fmt.Errorf("failed to get player season stats: %w", err)
 	}
 
 	return &response, nil
 }
 
 // GetFilteredPlayerStats returns filtered stats for a player
-func (c *Client) GetFilteredPlayerStats(playerID int, filter *StatsFilter) ([]SeasonTotal, error) {
+func (c *Client) GetFilteredPlayerStats(ctx context.Context, playerID int, filter *StatsFilter) ([]SeasonTotal, error) {
 	if playerID <= 0 {
 		return nil, fmt.Errorf("invalid player ID: %d", playerID)
 	}
 
-	landing, err := c.GetPlayerSeasonStats(playerID)
+	landing, err := c.GetPlayerSeasonStats(ctx, playerID)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +87,7 @@ func (c *Client) GetFilteredPlayerStats(playerID int, filter *StatsFilter) ([]Se
 
 		// Apply filters
 		if filter != nil {
-			if filter.GameType != 0 && season.GameTypeID != int(filter.GameType) {
+			if filter.GameType != 0 && season.GameTypeID != filter.GameType {
 				continue
 			}
 			if filter.SeasonID != 0 && season.Season != filter.SeasonID {
@@ -1,12 +1,14 @@
 package nhl_test
 
 import (
+	"context"
 	"go-nhl/client"
 	"testing"
 	"time"
 )
 
 func TestSearchPlayer(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	tests := []struct {
@@ -43,7 +45,7 @@ func TestSearchPlayer(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			results, err := client.SearchPlayer(tt.query)
+			results, err := client.SearchPlayer(ctx, tt.query)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SearchPlayer() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -56,10 +58,11 @@ func TestSearchPlayer(t *testing.T) {
 }
 
 func TestGetPlayerStats(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	// First get a player ID through search
-	results, err := client.SearchPlayer("Matthews")
+	results, err := client.SearchPlayer(ctx, "Matthews")
 	if err != nil || len(results) == 0 {
 		t.Fatal("Failed to get test player ID")
 	}
@@ -109,7 +112,7 @@ func TestGetPlayerStats(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stats, err := client.GetPlayerStats(tt.playerID, tt.isGoalie, tt.reportType, tt.filter)
+			stats, err := client.GetPlayerStats(ctx, tt.playerID, tt.isGoalie, tt.reportType, tt.filter)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPlayerStats() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -121,11 +124,20 @@ func TestGetPlayerStats(t *testing.T) {
 	}
 }
 
+func TestGetPlayerStatsGameType(t *testing.T) {
+	client := nhl.NewClient()
+	filter := &nhl.StatsFilter{GameType: nhl.GameTypePlayoffs}
+	if _, err := client.GetPlayerStats(context.Background(), 8478402, false, "playoffs", filter); err == nil {
+		t.Error("GetPlayerStats() with a game type returned no error")
+	}
+}
+
 func TestGetPlayerSeasonStats(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	// First get a player ID through search
-	results, err := client.SearchPlayer("Matthews")
+	results, err := client.SearchPlayer(ctx, "Matthews")
 	if err != nil || len(results) == 0 {
 		t.Fatal("Failed to get test player ID")
 	}
@@ -150,7 +162,7 @@ func TestGetPlayerSeasonStats(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stats, err := client.GetPlayerSeasonStats(tt.playerID)
+			stats, err := client.GetPlayerSeasonStats(ctx, tt.playerID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPlayerSeasonStats() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -163,10 +175,11 @@ func TestGetPlayerSeasonStats(t *testing.T) {
 }
 
 func TestGetFilteredPlayerStats(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	// First get a player ID through search
-	results, err := client.SearchPlayer("Matthews")
+	results, err := client.SearchPlayer(ctx, "Matthews")
 	if err != nil || len(results) == 0 {
 		t.Fatal("Failed to get test player ID")
 	}
@@ -208,7 +221,7 @@ func TestGetFilteredPlayerStats(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stats, err := client.GetFilteredPlayerStats(tt.playerID, tt.filter)
+			stats, err := client.GetFilteredPlayerStats(ctx, tt.playerID, tt.filter)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetFilteredPlayerStats() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,213 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"go-nhl/internal/formatters"
+	"strings"
+)
+
+// SeriesState describes how far a playoff series has progressed
+type SeriesState string
+
+const (
+	SeriesUndetermined SeriesState = "undetermined" // a team has not qualified yet
+	SeriesNotStarted   SeriesState = "not-started"
+	SeriesInProgress   SeriesState = "in-progress"
+	SeriesComplete     SeriesState = "complete"
+)
+
+// winsToAdvance is the number of wins that takes a modern series
+const winsToAdvance = 4
+
+// PlayoffBracket is the playoff bracket for one year
+type PlayoffBracket struct {
+	BracketLogo string                 `json:"bracketLogo"`
+	Series      []PlayoffBracketSeries `json:"series"`
+}
+
+// PlayoffBracketSeries is one series in the playoff bracket
+type PlayoffBracketSeries struct {
+	SeriesURL            string       `json:"seriesUrl"`
+	SeriesTitle          string       `json:"seriesTitle"`
+	SeriesAbbrev         string       `json:"seriesAbbrev"`
+	SeriesLetter         string       `json:"seriesLetter"`
+	PlayoffRound         int          `json:"playoffRound"`
+	TopSeedRankAbbrev    string       `json:"topSeedRankAbbrev"`
+	TopSeedWins          int          `json:"topSeedWins"`
+	BottomSeedRankAbbrev string       `json:"bottomSeedRankAbbrev"`
+	BottomSeedWins       int          `json:"bottomSeedWins"`
+	WinningTeamID        int          `json:"winningTeamId,omitempty"`
+	LosingTeamID         int          `json:"losingTeamId,omitempty"`
+	TopSeedTeam          *PlayoffTeam `json:"topSeedTeam,omitempty"`
+	BottomSeedTeam       *PlayoffTeam `json:"bottomSeedTeam,omitempty"`
+	ConferenceAbbrev     string       `json:"conferenceAbbrev,omitempty"`
+	ConferenceName       string       `json:"conferenceName,omitempty"`
+}
+
+// PlayoffTeam is a team in a playoff series. Conference, record, division
+// and seed are only filled by GetPlayoffSeries.
+type PlayoffTeam struct {
+	ID                       int                `json:"id"`
+	Abbrev                   string             `json:"abbrev"`
+	Name                     LanguageNames      `json:"name"`
+	CommonName               LanguageNames      `json:"commonName"`
+	PlaceNameWithPreposition LanguageNames      `json:"placeNameWithPreposition"`
+	Logo                     string             `json:"logo"`
+	DarkLogo                 string             `json:"darkLogo"`
+	Conference               *PlayoffConference `json:"conference,omitempty"`
+	Record                   string             `json:"record,omitempty"`
+	SeriesWins               int                `json:"seriesWins"`
+	DivisionAbbrev           string             `json:"divisionAbbrev,omitempty"`
+	Seed                     int                `json:"seed,omitempty"`
+}
+
+// PlayoffConference is the conference a playoff team plays in
+type PlayoffConference struct {
+	Name   string `json:"name"`
+	Abbrev string `json:"abbrev"`
+}
+
+// PlayoffSeries is a playoff series with its games
+type PlayoffSeries struct {
+	Round          int           `json:"round"`
+	RoundAbbrev    string        `json:"roundAbbrev"`
+	RoundLabel     string        `json:"roundLabel"`
+	SeriesLetter   string        `json:"seriesLetter"`
+	SeriesLogo     string        `json:"seriesLogo"`
+	NeededToWin    int           `json:"neededToWin"`
+	Length         int           `json:"length"`
+	TopSeedTeam    PlayoffTeam   `json:"topSeedTeam"`
+	BottomSeedTeam PlayoffTeam   `json:"bottomSeedTeam"`
+	Games          []PlayoffGame `json:"games"`
+}
+
+// PlayoffGame is a game in a playoff series
+type PlayoffGame struct {
+	ID           GameID              `json:"id"`
+	Season       int                 `json:"season"`
+	GameType     GameType            `json:"gameType"`
+	GameNumber   int                 `json:"gameNumber"`
+	IfNecessary  bool                `json:"ifNecessary"`
+	Venue        Venue               `json:"venue"`
+	NeutralSite  bool                `json:"neutralSite"`
+	StartTimeUTC string              `json:"startTimeUTC"`
+	GameState    GameState           `json:"gameState"`
+	AwayTeam     Team                `json:"awayTeam"`
+	HomeTeam     Team                `json:"homeTeam"`
+	GameOutcome  *GameOutcome        `json:"gameOutcome,omitempty"`
+	SeriesStatus PlayoffSeriesStatus `json:"seriesStatus"`
+}
+
+// PlayoffSeriesStatus is the state of a series after a game
+type PlayoffSeriesStatus struct {
+	Round                int    `json:"round"`
+	SeriesAbbrev         string `json:"seriesAbbrev"`
+	SeriesLetter         string `json:"seriesLetter"`
+	NeededToWin          int    `json:"neededToWin"`
+	TopSeedTeamAbbrev    string `json:"topSeedTeamAbbrev"`
+	TopSeedWins          int    `json:"topSeedWins"`
+	BottomSeedTeamAbbrev string `json:"bottomSeedTeamAbbrev"`
+	BottomSeedWins       int    `json:"bottomSeedWins"`
+}
+
+// GetPlayoffBracket returns the playoff bracket for the year the playoffs
+// were played in, such as 2024 for the 2023-2024 season. If year is 0 the
+// current season's playoffs are used.
+func (c *Client) GetPlayoffBracket(ctx context.Context, year int) (*PlayoffBracket, error) {
+	if year == 0 {
+		year = formatters.GetCurrentSeasonID() % 10000
+	}
+	if year < 0 {
+		return nil, fmt.Errorf("invalid playoff year: %d", year)
+	}
+
+	url := fmt.Sprintf("%s/playoff-bracket/%d", c.baseURL, year)
+	var response PlayoffBracket
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get playoff bracket: %w", err)
+	}
+	return &response, nil
+}
+
+// GetPlayoffSeries returns a playoff series and its games. Series are
+// lettered A to O through the bracket, first round first.
+func (c *Client) GetPlayoffSeries(ctx context.Context, seasonID int, seriesLetter string) (*PlayoffSeries, error) {
+	if seasonID == 0 {
+		seasonID = formatters.GetCurrentSeasonID()
+	}
+	if seasonID < 0 {
+		return nil, fmt.Errorf("invalid season ID: %d", seasonID)
+	}
+	letter := strings.ToLower(strings.TrimSpace(seriesLetter))
+	if len(letter) != 1 || letter[0] < 'a' || letter[0] > 'z' {
+		return nil, fmt.Errorf("invalid series letter: %q", seriesLetter)
+	}
+
+	url := fmt.Sprintf("%s/schedule/playoff-series/%d/%s", c.baseURL, seasonID, letter)
+	var response PlayoffSeries
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get playoff series: %w", err)
+	}
+	return &response, nil
+}
+
+// Round returns the series in a round of the bracket, in letter order
+func (b *PlayoffBracket) Round(round int) []PlayoffBracketSeries {
+	var series []PlayoffBracketSeries
+	for _, s := range b.Series {
+		if s.PlayoffRound == round {
+			series = append(series, s)
+		}
+	}
+	return series
+}
+
+// State reports how far the series has progressed
+func (s PlayoffBracketSeries) State() SeriesState {
+	return seriesState(s.TopSeedTeam != nil && s.BottomSeedTeam != nil, s.TopSeedWins, s.BottomSeedWins, winsToAdvance)
+}
+
+// Winner returns the team that won the series, or nil if it is not over
+func (s PlayoffBracketSeries) Winner() *PlayoffTeam {
+	if s.State() != SeriesComplete {
+		return nil
+	}
+	if s.TopSeedWins > s.BottomSeedWins {
+		return s.TopSeedTeam
+	}
+	return s.BottomSeedTeam
+}
+
+// State reports how far the series has progressed
+func (s *PlayoffSeries) State() SeriesState {
+	needed := s.NeededToWin
+	if needed == 0 {
+		needed = winsToAdvance
+	}
+	return seriesState(s.TopSeedTeam.ID != 0 && s.BottomSeedTeam.ID != 0, s.TopSeedTeam.SeriesWins, s.BottomSeedTeam.SeriesWins, needed)
+}
+
+// GameIDs returns the IDs of the series games, in game order, including
+// games that are only played if necessary
+func (s *PlayoffSeries) GameIDs() []GameID {
+	ids := make([]GameID, 0, len(s.Games))
+	for _, game := range s.Games {
+		ids = append(ids, game.ID)
+	}
+	return ids
+}
+
+// seriesState works out a series' state from its teams and wins
+func seriesState(teamsKnown bool, topWins, bottomWins, needed int) SeriesState {
+	switch {
+	case !teamsKnown:
+		return SeriesUndetermined
+	case topWins >= needed || bottomWins >= needed:
+		return SeriesComplete
+	case topWins+bottomWins > 0:
+		return SeriesInProgress
+	default:
+		return SeriesNotStarted
+	}
+}
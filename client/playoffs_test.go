@@ -0,0 +1,71 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestGetPlayoffSeries(t *testing.T) {
+	var path string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			path = req.URL.Path
+			return mockResponse(http.StatusOK, PlayoffSeries{
+				SeriesLetter:   "a",
+				NeededToWin:    4,
+				TopSeedTeam:    PlayoffTeam{ID: 13, Abbrev: "FLA", SeriesWins: 3},
+				BottomSeedTeam: PlayoffTeam{ID: 14, Abbrev: "TBL", SeriesWins: 1},
+				Games:          []PlayoffGame{{ID: 2023030111}, {ID: 2023030112}, {ID: 2023030113}, {ID: 2023030114}},
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	series, err := client.GetPlayoffSeries(context.Background(), 20232024, " A")
+	if err != nil {
+		t.Fatalf("GetPlayoffSeries() error = %v", err)
+	}
+	if path != "/v1/schedule/playoff-series/20232024/a" {
+		t.Errorf("GetPlayoffSeries() requested %s", path)
+	}
+	if series.State() != SeriesInProgress {
+		t.Errorf("State() = %s, want %s", series.State(), SeriesInProgress)
+	}
+	if ids := series.GameIDs(); !slices.Equal(ids, []GameID{2023030111, 2023030112, 2023030113, 2023030114}) {
+		t.Errorf("GameIDs() = %v", ids)
+	}
+
+	if _, err := client.GetPlayoffSeries(context.Background(), 20232024, "AB"); err == nil {
+		t.Error("GetPlayoffSeries() with an invalid letter returned no error")
+	}
+}
+
+func TestPlayoffBracketSeriesState(t *testing.T) {
+	fla := &PlayoffTeam{ID: 13, Abbrev: "FLA"}
+	edm := &PlayoffTeam{ID: 22, Abbrev: "EDM"}
+
+	tests := []struct {
+		name       string
+		series     PlayoffBracketSeries
+		want       SeriesState
+		wantWinner *PlayoffTeam
+	}{
+		{name: "Awaiting opponent", series: PlayoffBracketSeries{TopSeedTeam: fla}, want: SeriesUndetermined},
+		{name: "Not started", series: PlayoffBracketSeries{TopSeedTeam: fla, BottomSeedTeam: edm}, want: SeriesNotStarted},
+		{name: "In progress", series: PlayoffBracketSeries{TopSeedTeam: fla, BottomSeedTeam: edm, TopSeedWins: 3, BottomSeedWins: 3}, want: SeriesInProgress},
+		{name: "Bottom seed wins", series: PlayoffBracketSeries{TopSeedTeam: fla, BottomSeedTeam: edm, TopSeedWins: 2, BottomSeedWins: 4}, want: SeriesComplete, wantWinner: edm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.series.State(); got != tt.want {
+				t.Errorf("State() = %s, want %s", got, tt.want)
+			}
+			if got := tt.series.Winner(); got != tt.wantWinner {
+				t.Errorf("Winner() = %v, want %v", got, tt.wantWinner)
+			}
+		})
+	}
+}
@@ -0,0 +1,478 @@
+package nhl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EventType is the kind of a play-by-play event, its typeDescKey
+type EventType string
+
+const (
+	EventFaceoff           EventType = "faceoff"
+	EventHit               EventType = "hit"
+	EventGiveaway          EventType = "giveaway"
+	EventGoal              EventType = "goal"
+	EventShotOnGoal        EventType = "shot-on-goal"
+	EventMissedShot        EventType = "missed-shot"
+	EventBlockedShot       EventType = "blocked-shot"
+	EventPenalty           EventType = "penalty"
+	EventStoppage          EventType = "stoppage"
+	EventPeriodStart       EventType = "period-start"
+	EventPeriodEnd         EventType = "period-end"
+	EventShootoutComplete  EventType = "shootout-complete"
+	EventGameEnd           EventType = "game-end"
+	EventTakeaway          EventType = "takeaway"
+	EventDelayedPenalty    EventType = "delayed-penalty"
+	EventFailedShotAttempt EventType = "failed-shot-attempt" // a shootout attempt that missed the net
+)
+
+// eventTypeCodes maps each event type to the typeCode sent with it
+var eventTypeCodes = map[EventType]int{
+	EventFaceoff:           502,
+	EventHit:               503,
+	EventGiveaway:          504,
+	EventGoal:              505,
+	EventShotOnGoal:        506,
+	EventMissedShot:        507,
+	EventBlockedShot:       508,
+	EventPenalty:           509,
+	EventStoppage:          516,
+	EventPeriodStart:       520,
+	EventPeriodEnd:         521,
+	EventShootoutComplete:  523,
+	EventGameEnd:           524,
+	EventTakeaway:          525,
+	EventDelayedPenalty:    535,
+	EventFailedShotAttempt: 537,
+}
+
+// ParseEventType parses an event type such as goal, shot-on-goal or
+// "Shot on goal", ignoring case
+func ParseEventType(s string) (EventType, error) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	key = strings.NewReplacer(" ", "-", "_", "-").Replace(key)
+	if t := EventType(key); t.Known() {
+		return t, nil
+	}
+	return "", fmt.Errorf("invalid event type %q, want one such as goal, shot-on-goal, hit or penalty", s)
+}
+
+// Known reports whether the event type is one of the types above
+func (t EventType) Known() bool {
+	_, ok := eventTypeCodes[t]
+	return ok
+}
+
+// Code returns the event type's typeCode, such as 505 for a goal, or 0 for
+// an unknown type
+func (t EventType) Code() int {
+	return eventTypeCodes[t]
+}
+
+// IsShotAttempt reports whether the event is a shot at the net: a goal, or
+// a shot that was saved, missed or blocked
+func (t EventType) IsShotAttempt() bool {
+	switch t {
+	case EventGoal, EventShotOnGoal, EventMissedShot, EventBlockedShot:
+		return true
+	}
+	return false
+}
+
+// Location is where on the ice a play happened. X runs from -100 to 100
+// along the rink and Y from -42.5 to 42.5 across it. Zone is O, D or N for
+// the offensive, defensive or neutral zone of the team that made the play.
+type Location struct {
+	X    float64
+	Y    float64
+	Zone string
+}
+
+// ScoringPlayer is a goal scorer or assister with their season total
+// after the goal
+type ScoringPlayer struct {
+	PlayerID int
+	Total    int
+}
+
+// FaceoffPlay is a faceoff, made by the winner's team
+type FaceoffPlay struct {
+	TeamID   int
+	WinnerID int
+	LoserID  int
+	Location Location
+}
+
+// HitPlay is a hit, made by the hitter's team
+type HitPlay struct {
+	TeamID   int
+	HitterID int
+	HitteeID int
+	Location Location
+}
+
+// TurnoverPlay is a giveaway or takeaway, made by the team of the player
+// who gave the puck away or took it
+type TurnoverPlay struct {
+	TeamID   int
+	PlayerID int
+	Location Location
+}
+
+// GoalPlay is a goal. GoalieID is 0 for an empty net goal.
+type GoalPlay struct {
+	TeamID       int
+	Scorer       ScoringPlayer
+	Assists      []ScoringPlayer
+	GoalieID     int
+	ShotType     string
+	AwayScore    int
+	HomeScore    int
+	HighlightURL string
+	Location     Location
+}
+
+// ShotPlay is a shot on goal, a missed shot or a failed shootout attempt,
+// made by the shooter's team. Reason says how a shot missed, such as
+// wide-of-net, and the shots on goal are the totals after a shot on goal.
+type ShotPlay struct {
+	TeamID    int
+	ShooterID int
+	GoalieID  int
+	ShotType  string
+	Reason    string
+	AwaySOG   int
+	HomeSOG   int
+	Location  Location
+}
+
+// BlockedShotPlay is a blocked shot, made by the blocker's team. Reason is
+// blocked, or teammate-blocked for a shot blocked by the shooter's teammate.
+type BlockedShotPlay struct {
+	TeamID    int
+	ShooterID int
+	BlockerID int
+	Reason    string
+	Location  Location
+}
+
+// PenaltyPlay is a penalty, made by the penalized team. Type is MIN, MAJ,
+// MIS or MAT for a minor, major, misconduct or match penalty, and Desc what
+// it was for, such as hooking.
+type PenaltyPlay struct {
+	TeamID        int
+	CommittedByID int
+	DrawnByID     int
+	ServedByID    int
+	Type          string
+	Desc          string
+	Minutes       int
+	Location      Location
+}
+
+// StoppagePlay is a stoppage in play, with why it stopped, such as icing
+type StoppagePlay struct {
+	Reason          string
+	SecondaryReason string
+}
+
+// DelayedPenaltyPlay is a delayed penalty call, made by the team that will
+// be penalized
+type DelayedPenaltyPlay struct {
+	TeamID int
+}
+
+// Type returns the event's type
+func (p PlayEvent) Type() EventType {
+	return p.TypeDescKey
+}
+
+// Period returns the number of the period the event happened in, 4 for the
+// first overtime
+func (p PlayEvent) Period() int {
+	return p.PeriodDescriptor.Number
+}
+
+// TeamID returns the ID of the team that made the play, or 0 for events
+// such as stoppages that belong to neither team
+func (p PlayEvent) TeamID() int {
+	return p.Details.EventOwnerTeamID
+}
+
+// Location returns where on the ice the play happened
+func (p PlayEvent) Location() Location {
+	return Location{X: p.Details.XCoord, Y: p.Details.YCoord, Zone: p.Details.ZoneCode}
+}
+
+// PlayerIDs returns the players involved in the play, including the goalie
+// a shot was on
+func (p PlayEvent) PlayerIDs() []int {
+	d := p.Details
+	var ids []int
+	for _, id := range []int{
+		d.PlayerID, d.WinningPlayerID, d.LosingPlayerID, d.HittingPlayerID, d.HitteePlayerID,
+		d.ScoringPlayerID, d.Assist1PlayerID, d.Assist2PlayerID, d.ShootingPlayerID, d.BlockingPlayerID,
+		d.CommittedByPlayerID, d.DrawnByPlayerID, d.ServedByPlayerID, d.GoalieInNetID,
+	} {
+		if id != 0 && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Faceoff returns the event as a faceoff, if it is one
+func (p PlayEvent) Faceoff() (FaceoffPlay, bool) {
+	d := p.Details
+	return FaceoffPlay{
+		TeamID:   d.EventOwnerTeamID,
+		WinnerID: d.WinningPlayerID,
+		LoserID:  d.LosingPlayerID,
+		Location: p.Location(),
+	}, p.TypeDescKey == EventFaceoff
+}
+
+// Hit returns the event as a hit, if it is one
+func (p PlayEvent) Hit() (HitPlay, bool) {
+	d := p.Details
+	return HitPlay{
+		TeamID:   d.EventOwnerTeamID,
+		HitterID: d.HittingPlayerID,
+		HitteeID: d.HitteePlayerID,
+		Location: p.Location(),
+	}, p.TypeDescKey == EventHit
+}
+
+// Giveaway returns the event as a giveaway, if it is one
+func (p PlayEvent) Giveaway() (TurnoverPlay, bool) {
+	return p.turnover(), p.TypeDescKey == EventGiveaway
+}
+
+// Takeaway returns the event as a takeaway, if it is one
+func (p PlayEvent) Takeaway() (TurnoverPlay, bool) {
+	return p.turnover(), p.TypeDescKey == EventTakeaway
+}
+
+func (p PlayEvent) turnover() TurnoverPlay {
+	return TurnoverPlay{
+		TeamID:   p.Details.EventOwnerTeamID,
+		PlayerID: p.Details.PlayerID,
+		Location: p.Location(),
+	}
+}
+
+// Goal returns the event as a goal, if it is one
+func (p PlayEvent) Goal() (GoalPlay, bool) {
+	d := p.Details
+	goal := GoalPlay{
+		TeamID:       d.EventOwnerTeamID,
+		Scorer:       ScoringPlayer{PlayerID: d.ScoringPlayerID, Total: d.ScoringPlayerTotal},
+		GoalieID:     d.GoalieInNetID,
+		ShotType:     d.ShotType,
+		AwayScore:    d.AwayScore,
+		HomeScore:    d.HomeScore,
+		HighlightURL: d.HighlightClipSharingURL,
+		Location:     p.Location(),
+	}
+	if d.Assist1PlayerID != 0 {
+		goal.Assists = append(goal.Assists, ScoringPlayer{PlayerID: d.Assist1PlayerID, Total: d.Assist1PlayerTotal})
+	}
+	if d.Assist2PlayerID != 0 {
+		goal.Assists = append(goal.Assists, ScoringPlayer{PlayerID: d.Assist2PlayerID, Total: d.Assist2PlayerTotal})
+	}
+	return goal, p.TypeDescKey == EventGoal
+}
+
+// ShotOnGoal returns the event as a shot on goal, if it is one
+func (p PlayEvent) ShotOnGoal() (ShotPlay, bool) {
+	return p.shot(), p.TypeDescKey == EventShotOnGoal
+}
+
+// MissedShot returns the event as a missed shot, if it is one
+func (p PlayEvent) MissedShot() (ShotPlay, bool) {
+	return p.shot(), p.TypeDescKey == EventMissedShot
+}
+
+// FailedShotAttempt returns the event as a failed shootout attempt, if it
+// is one
+func (p PlayEvent) FailedShotAttempt() (ShotPlay, bool) {
+	return p.shot(), p.TypeDescKey == EventFailedShotAttempt
+}
+
+func (p PlayEvent) shot() ShotPlay {
+	d := p.Details
+	return ShotPlay{
+		TeamID:    d.EventOwnerTeamID,
+		ShooterID: d.ShootingPlayerID,
+		GoalieID:  d.GoalieInNetID,
+		ShotType:  d.ShotType,
+		Reason:    d.Reason,
+		AwaySOG:   d.AwaySOG,
+		HomeSOG:   d.HomeSOG,
+		Location:  p.Location(),
+	}
+}
+
+// BlockedShot returns the event as a blocked shot, if it is one
+func (p PlayEvent) BlockedShot() (BlockedShotPlay, bool) {
+	d := p.Details
+	return BlockedShotPlay{
+		TeamID:    d.EventOwnerTeamID,
+		ShooterID: d.ShootingPlayerID,
+		BlockerID: d.BlockingPlayerID,
+		Reason:    d.Reason,
+		Location:  p.Location(),
+	}, p.TypeDescKey == EventBlockedShot
+}
+
+// Penalty returns the event as a penalty, if it is one
+func (p PlayEvent) Penalty() (PenaltyPlay, bool) {
+	d := p.Details
+	return PenaltyPlay{
+		TeamID:        d.EventOwnerTeamID,
+		CommittedByID: d.CommittedByPlayerID,
+		DrawnByID:     d.DrawnByPlayerID,
+		ServedByID:    d.ServedByPlayerID,
+		Type:          d.TypeCode,
+		Desc:          d.DescKey,
+		Minutes:       d.Duration,
+		Location:      p.Location(),
+	}, p.TypeDescKey == EventPenalty
+}
+
+// Stoppage returns the event as a stoppage, if it is one
+func (p PlayEvent) Stoppage() (StoppagePlay, bool) {
+	return StoppagePlay{
+		Reason:          p.Details.Reason,
+		SecondaryReason: p.Details.SecondaryReason,
+	}, p.TypeDescKey == EventStoppage
+}
+
+// DelayedPenalty returns the event as a delayed penalty, if it is one
+func (p PlayEvent) DelayedPenalty() (DelayedPenaltyPlay, bool) {
+	return DelayedPenaltyPlay{TeamID: p.Details.EventOwnerTeamID}, p.TypeDescKey == EventDelayedPenalty
+}
+
+// PeriodStart returns the period the event started, if it is a period start
+func (p PlayEvent) PeriodStart() (PeriodDescriptor, bool) {
+	return p.PeriodDescriptor, p.TypeDescKey == EventPeriodStart
+}
+
+// PeriodEnd returns the period the event ended, if it is a period end
+func (p PlayEvent) PeriodEnd() (PeriodDescriptor, bool) {
+	return p.PeriodDescriptor, p.TypeDescKey == EventPeriodEnd
+}
+
+// ShootoutComplete returns the shootout period, if the event ended it
+func (p PlayEvent) ShootoutComplete() (PeriodDescriptor, bool) {
+	return p.PeriodDescriptor, p.TypeDescKey == EventShootoutComplete
+}
+
+// GameEnd returns the game's last period, if the event ended the game
+func (p PlayEvent) GameEnd() (PeriodDescriptor, bool) {
+	return p.PeriodDescriptor, p.TypeDescKey == EventGameEnd
+}
+
+// Name returns the player's first and last name
+func (r RosterSpot) Name() string {
+	return r.FirstName.Default + " " + r.LastName.Default
+}
+
+// Player returns the roster spot of playerID
+func (r *PlayByPlayResponse) Player(playerID int) (RosterSpot, bool) {
+	for _, spot := range r.RosterSpots {
+		if spot.PlayerID == playerID {
+			return spot, true
+		}
+	}
+	return RosterSpot{}, false
+}
+
+// PlayerName returns the name of playerID, or "" if they are not on either
+// roster
+func (r *PlayByPlayResponse) PlayerName(playerID int) string {
+	if spot, ok := r.Player(playerID); ok {
+		return spot.Name()
+	}
+	return ""
+}
+
+// FindPlayers returns the roster spots whose last or full name is name,
+// ignoring case and accents
+func (r *PlayByPlayResponse) FindPlayers(name string) []RosterSpot {
+	folded := foldName(name)
+	var spots []RosterSpot
+	for _, spot := range r.RosterSpots {
+		if foldName(spot.LastName.Default) == folded || foldName(spot.Name()) == folded {
+			spots = append(spots, spot)
+		}
+	}
+	return spots
+}
+
+// TeamID returns the ID of the home or away team with abbrev, or 0 if
+// neither team has it
+func (r *PlayByPlayResponse) TeamID(abbrev string) int {
+	switch {
+	case strings.EqualFold(abbrev, r.HomeTeam.Abbrev):
+		return r.HomeTeam.ID
+	case strings.EqualFold(abbrev, r.AwayTeam.Abbrev):
+		return r.AwayTeam.ID
+	}
+	return 0
+}
+
+// PlayFilter picks plays out of a game's play-by-play. Empty fields match
+// every play.
+type PlayFilter struct {
+	Team      string      // abbreviation of the team that made the play, such as NYR
+	PlayerIDs []int       // players involved in the play
+	Periods   []int       // period numbers, 4 for the first overtime
+	Types     []EventType // such as EventGoal
+	Strengths []Strength  // strength of the team that made the play
+}
+
+// IsZero reports whether the filter matches every play
+func (f *PlayFilter) IsZero() bool {
+	return f == nil || (f.Team == "" && len(f.PlayerIDs) == 0 && len(f.Periods) == 0 &&
+		len(f.Types) == 0 && len(f.Strengths) == 0)
+}
+
+// FilterPlays returns the plays passing filter, in order. A nil filter
+// matches every play.
+func (r *PlayByPlayResponse) FilterPlays(filter *PlayFilter) []PlayEvent {
+	if filter.IsZero() {
+		return r.Plays
+	}
+	teamID := 0
+	if filter.Team != "" {
+		if teamID = r.TeamID(filter.Team); teamID == 0 {
+			return nil
+		}
+	}
+
+	var plays []PlayEvent
+	for _, play := range r.Plays {
+		if teamID != 0 && play.TeamID() != teamID {
+			continue
+		}
+		if len(filter.PlayerIDs) > 0 && !slices.ContainsFunc(play.PlayerIDs(), func(id int) bool {
+			return slices.Contains(filter.PlayerIDs, id)
+		}) {
+			continue
+		}
+		if len(filter.Periods) > 0 && !slices.Contains(filter.Periods, play.Period()) {
+			continue
+		}
+		if len(filter.Types) > 0 && !slices.Contains(filter.Types, play.TypeDescKey) {
+			continue
+		}
+		if len(filter.Strengths) > 0 && !slices.Contains(filter.Strengths, r.Strength(play, play.TeamID())) {
+			continue
+		}
+		plays = append(plays, play)
+	}
+	return plays
+}
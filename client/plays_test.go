@@ -0,0 +1,126 @@
+package nhl
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestParseEventType(t *testing.T) {
+	for input, want := range map[string]EventType{"goal": EventGoal, "Shot on goal": EventShotOnGoal, " DELAYED_PENALTY": EventDelayedPenalty} {
+		if got, err := ParseEventType(input); err != nil || got != want {
+			t.Errorf("ParseEventType(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseEventType("fight"); err == nil {
+		t.Error("ParseEventType(fight) should fail")
+	}
+	if EventGoal.Code() != 505 || EventType("fight").Code() != 0 || !EventBlockedShot.IsShotAttempt() || EventHit.IsShotAttempt() {
+		t.Error("Code() or IsShotAttempt() is wrong")
+	}
+}
+
+func TestPlayEventAccessors(t *testing.T) {
+	var play PlayEvent
+	data := `{"eventId":5,"periodDescriptor":{"number":1,"periodType":"REG"},"timeInPeriod":"06:12","situationCode":"1551",
+		"typeCode":505,"typeDescKey":"goal","sortOrder":90,"details":{"eventOwnerTeamId":3,"scoringPlayerId":10,
+		"scoringPlayerTotal":30,"assist1PlayerId":93,"assist1PlayerTotal":30,"goalieInNetId":34,"shotType":"wrist",
+		"zoneCode":"O","xCoord":80,"yCoord":-5,"awayScore":1,"homeScore":0}}`
+	if err := json.Unmarshal([]byte(data), &play); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	goal, ok := play.Goal()
+	if !ok || goal.Scorer != (ScoringPlayer{PlayerID: 10, Total: 30}) || len(goal.Assists) != 1 || goal.GoalieID != 34 ||
+		goal.AwayScore != 1 || goal.Location != (Location{X: 80, Y: -5, Zone: "O"}) {
+		t.Errorf("Goal() = %+v, %v", goal, ok)
+	}
+	if _, ok := play.ShotOnGoal(); ok {
+		t.Error("ShotOnGoal() of a goal should not be ok")
+	}
+	if ids := play.PlayerIDs(); !equalInts(ids, []int{10, 93, 34}) {
+		t.Errorf("PlayerIDs() = %v, want [10 93 34]", ids)
+	}
+
+	penalty, ok := PlayEvent{TypeDescKey: EventPenalty, Details: EventDetails{
+		EventOwnerTeamID: 16, CommittedByPlayerID: 17, DrawnByPlayerID: 23, ServedByPlayerID: 98,
+		TypeCode: "MIN", DescKey: "tripping", Duration: 2,
+	}}.Penalty()
+	if !ok || penalty.TeamID != 16 || penalty.ServedByID != 98 || penalty.Type != "MIN" || penalty.Desc != "tripping" || penalty.Minutes != 2 {
+		t.Errorf("Penalty() = %+v, %v", penalty, ok)
+	}
+
+	takeaway, ok := PlayEvent{TypeDescKey: EventTakeaway, Details: EventDetails{EventOwnerTeamID: 3, PlayerID: 93}}.Takeaway()
+	if _, giveaway := (PlayEvent{TypeDescKey: EventTakeaway}).Giveaway(); !ok || takeaway.PlayerID != 93 || giveaway {
+		t.Errorf("Takeaway() = %+v, %v", takeaway, ok)
+	}
+
+	stoppage, ok := PlayEvent{TypeDescKey: EventStoppage, Details: EventDetails{Reason: "icing", SecondaryReason: "tv-timeout"}}.Stoppage()
+	if !ok || stoppage.Reason != "icing" || stoppage.SecondaryReason != "tv-timeout" {
+		t.Errorf("Stoppage() = %+v, %v", stoppage, ok)
+	}
+
+	if period, ok := (PlayEvent{TypeDescKey: EventShootoutComplete, PeriodDescriptor: PeriodDescriptor{Number: 5, PeriodType: PeriodTypeShootout}}).ShootoutComplete(); !ok || !period.PeriodType.IsShootout() {
+		t.Errorf("ShootoutComplete() = %+v, %v", period, ok)
+	}
+}
+
+func TestFilterPlays(t *testing.T) {
+	pbp := &PlayByPlayResponse{
+		AwayTeam: Team{ID: 3, Abbrev: "NYR"},
+		HomeTeam: Team{ID: 16, Abbrev: "CHI"},
+		Plays: []PlayEvent{
+			{EventID: 1, PeriodDescriptor: PeriodDescriptor{Number: 1}, TypeDescKey: EventPeriodStart, SituationCode: "1551"},
+			{EventID: 2, PeriodDescriptor: PeriodDescriptor{Number: 1}, TypeDescKey: EventFaceoff, SituationCode: "1551",
+				Details: EventDetails{EventOwnerTeamID: 3, WinningPlayerID: 93, LosingPlayerID: 98}},
+			{EventID: 3, PeriodDescriptor: PeriodDescriptor{Number: 2}, TypeDescKey: EventGoal, SituationCode: "1451",
+				Details: EventDetails{EventOwnerTeamID: 16, ScoringPlayerID: 98, GoalieInNetID: 31}},
+			{EventID: 4, PeriodDescriptor: PeriodDescriptor{Number: 3}, TypeDescKey: EventGoal, SituationCode: "1560",
+				Details: EventDetails{EventOwnerTeamID: 3, ScoringPlayerID: 10}},
+		},
+		RosterSpots: []RosterSpot{
+			{PlayerID: 10, TeamID: 3, FirstName: LanguageNames{Default: "Artemi"}, LastName: LanguageNames{Default: "Panarin"}},
+			{PlayerID: 34, TeamID: 16, FirstName: LanguageNames{Default: "Petr"}, LastName: LanguageNames{Default: "Mrázek"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter *PlayFilter
+		want   []int
+	}{
+		{name: "Nil", filter: nil, want: []int{1, 2, 3, 4}},
+		{name: "Team", filter: &PlayFilter{Team: "nyr"}, want: []int{2, 4}},
+		{name: "Other team", filter: &PlayFilter{Team: "BOS"}, want: nil},
+		{name: "Player", filter: &PlayFilter{PlayerIDs: []int{98}}, want: []int{2, 3}},
+		{name: "Goalie", filter: &PlayFilter{PlayerIDs: []int{31}}, want: []int{3}},
+		{name: "Periods", filter: &PlayFilter{Periods: []int{1, 3}}, want: []int{1, 2, 4}},
+		{name: "Types", filter: &PlayFilter{Types: []EventType{EventGoal}}, want: []int{3, 4}},
+		{name: "Strength", filter: &PlayFilter{Strengths: []Strength{StrengthPowerPlay, StrengthEmptyNet}}, want: []int{3, 4}},
+		{name: "Team and strength", filter: &PlayFilter{Team: "CHI", Strengths: []Strength{StrengthEmptyNet}}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []int
+			for _, play := range pbp.FilterPlays(tt.filter) {
+				ids = append(ids, play.EventID)
+			}
+			if !equalInts(ids, tt.want) {
+				t.Errorf("FilterPlays() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	if name := pbp.PlayerName(10); name != "Artemi Panarin" {
+		t.Errorf("PlayerName(10) = %q", name)
+	}
+	if name := pbp.PlayerName(98); name != "" {
+		t.Errorf("PlayerName(unknown) = %q, want empty", name)
+	}
+	if spots := pbp.FindPlayers("mrazek"); len(spots) != 1 || spots[0].PlayerID != 34 {
+		t.Errorf("FindPlayers(mrazek) = %v", spots)
+	}
+	if spots := pbp.FindPlayers("artemi panarin"); !slices.ContainsFunc(spots, func(s RosterSpot) bool { return s.PlayerID == 10 }) {
+		t.Errorf("FindPlayers(artemi panarin) = %v", spots)
+	}
+}
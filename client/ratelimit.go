@@ -0,0 +1,149 @@
+package nhl
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit describes a token bucket allowing Rate requests per second
+// on average, with bursts of up to Burst requests
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitHook is called whenever a request has to wait for the rate limiter
+type RateLimitHook func(host string, wait time.Duration)
+
+// WithRateLimit limits requests made to host (e.g. "api-web.nhle.com").
+// The limit is shared by every goroutine using the client.
+func WithRateLimit(host string, limit RateLimit) Option {
+	return func(c *Client) {
+		c.limiter.setLimit(host, limit)
+	}
+}
+
+// WithDefaultRateLimit limits requests to any host without its own limit.
+// Each host still gets a separate bucket.
+func WithDefaultRateLimit(limit RateLimit) Option {
+	return func(c *Client) {
+		c.limiter.setDefault(limit)
+	}
+}
+
+// WithRateLimitHook sets a hook called when a request waits for the rate limiter
+func WithRateLimitHook(hook RateLimitHook) Option {
+	return func(c *Client) {
+		c.limiter.hook = hook
+	}
+}
+
+// rateLimiter holds a token bucket per upstream host
+type rateLimiter struct {
+	mu           sync.Mutex
+	limits       map[string]RateLimit
+	defaultLimit *RateLimit
+	buckets      map[string]*tokenBucket
+	hook         RateLimitHook
+}
+
+func (l *rateLimiter) setLimit(host string, limit RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits == nil {
+		l.limits = make(map[string]RateLimit)
+	}
+	l.limits[host] = limit
+	delete(l.buckets, host)
+}
+
+func (l *rateLimiter) setDefault(limit RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.defaultLimit = &limit
+	l.buckets = nil
+}
+
+// wait blocks until a request to host is allowed or ctx is done
+func (l *rateLimiter) wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	bucket := l.bucket(host)
+	if bucket == nil {
+		l.mu.Unlock()
+		return nil
+	}
+	delay := bucket.reserve(time.Now())
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if l.hook != nil {
+		l.hook(host, delay)
+	}
+	if err := sleep(ctx, delay); err != nil {
+		// Give back the token we will not use
+		l.mu.Lock()
+		bucket.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// bucket returns the token bucket for host, or nil if host is not limited.
+// The caller must hold l.mu.
+func (l *rateLimiter) bucket(host string) *tokenBucket {
+	if b, ok := l.buckets[host]; ok {
+		return b
+	}
+
+	limit, ok := l.limits[host]
+	if !ok {
+		if l.defaultLimit == nil {
+			return nil
+		}
+		limit = *l.defaultLimit
+	}
+	if limit.Rate <= 0 {
+		return nil
+	}
+
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	b := &tokenBucket{rate: limit.Rate, burst: burst, tokens: burst}
+	if l.buckets == nil {
+		l.buckets = make(map[string]*tokenBucket)
+	}
+	l.buckets[host] = b
+	return b
+}
+
+// tokenBucket refills at rate tokens per second up to burst. Tokens may go
+// negative, which queues later callers behind earlier reservations.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token and returns how long to wait before using it
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
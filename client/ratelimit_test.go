@@ -0,0 +1,95 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	start := time.Date(2024, 2, 9, 20, 0, 0, 0, time.UTC)
+	b := &tokenBucket{rate: 2, burst: 2, tokens: 2}
+
+	steps := []struct {
+		at   time.Duration
+		want time.Duration
+	}{
+		{at: 0, want: 0},                      // burst token 1
+		{at: 0, want: 0},                      // burst token 2
+		{at: 0, want: 500 * time.Millisecond}, // queued for next refill
+		{at: 0, want: time.Second},            // queued behind the previous reservation
+		{at: 3 * time.Second, want: 0},        // refilled to burst
+		{at: 3 * time.Second, want: 0},        // second burst token
+		{at: 3*time.Second + 250*time.Millisecond, want: 250 * time.Millisecond}, // half a token refilled
+	}
+
+	for i, step := range steps {
+		if got := b.reserve(start.Add(step.at)); got != step.want {
+			t.Errorf("step %d: reserve() = %v, want %v", i, got, step.want)
+		}
+	}
+}
+
+func TestRateLimiterPerHost(t *testing.T) {
+	var mu sync.Mutex
+	waits := make(map[string]int)
+	client := NewClient(
+		WithRateLimit("limited.example.com", RateLimit{Rate: 100, Burst: 1}),
+		WithRateLimitHook(func(host string, wait time.Duration) {
+			mu.Lock()
+			waits[host]++
+			mu.Unlock()
+		}),
+		WithHTTPClient(&mockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusOK, map[string]string{})
+			},
+		}),
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		for _, host := range []string{"limited.example.com", "free.example.com"} {
+			wg.Add(1)
+			go func(host string) {
+				defer wg.Done()
+				var result map[string]string
+				if err := client.get(context.Background(), "https://"+host+"/test", &result); err != nil {
+					t.Errorf("get() error = %v", err)
+				}
+			}(host)
+		}
+	}
+	wg.Wait()
+
+	if waits["limited.example.com"] != 4 {
+		t.Errorf("limited host waited %d times, want 4", waits["limited.example.com"])
+	}
+	if waits["free.example.com"] != 0 {
+		t.Errorf("unlimited host waited %d times, want 0", waits["free.example.com"])
+	}
+}
+
+func TestRateLimiterContextCanceled(t *testing.T) {
+	client := NewClient(
+		WithDefaultRateLimit(RateLimit{Rate: 0.001, Burst: 1}),
+		WithHTTPClient(&mockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusOK, map[string]string{})
+			},
+		}),
+	)
+
+	var result map[string]string
+	if err := client.get(context.Background(), "https://api.example.com/test", &result); err != nil {
+		t.Fatalf("first get() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := client.get(ctx, "https://api.example.com/test", &result); err == nil {
+		t.Error("get() should fail when the context expires while rate limited")
+	}
+}
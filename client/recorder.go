@@ -0,0 +1,174 @@
+package nhl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ErrNoFixture is returned by a Replayer when no response was recorded for a URL
+var ErrNoFixture = errors.New("no recorded response")
+
+// Fixture is an upstream response as stored by a Recorder
+type Fixture struct {
+	URL        string          `json:"url"`
+	StatusCode int             `json:"statusCode"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	Text       string          `json:"text,omitempty"` // Body when it is not valid JSON
+}
+
+// recordedHeaders are the response headers kept in fixtures
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// Recorder is an HTTPClient that passes requests to another HTTPClient and
+// saves every response into a fixture directory keyed by URL
+type Recorder struct {
+	dir  string
+	next HTTPClient
+}
+
+// NewRecorder creates a Recorder writing fixtures to dir. Requests are sent
+// with next, or a default http.Client when next is nil.
+func NewRecorder(dir string, next HTTPClient) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	if next == nil {
+		next = &http.Client{}
+	}
+	return &Recorder{dir: dir, next: next}, nil
+}
+
+// Do implements HTTPClient
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	fixture := Fixture{
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     http.Header{},
+	}
+	for _, key := range recordedHeaders {
+		if value := resp.Header.Get(key); value != "" {
+			fixture.Header.Set(key, value)
+		}
+	}
+	if json.Valid(body) {
+		fixture.Body = body
+	} else {
+		fixture.Text = string(body)
+	}
+
+	if err := r.save(req.URL, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+	return resp, nil
+}
+
+// save writes a fixture for u
+func (r *Recorder) save(u *url.URL, fixture *Fixture) error {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := fixturePath(r.dir, u)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append(data, '\n'))
+}
+
+// Replayer is an HTTPClient that serves responses saved by a Recorder
+// without any network access
+type Replayer struct {
+	dir string
+}
+
+// NewReplayer creates a Replayer reading fixtures from dir
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{dir: dir}
+}
+
+// Do implements HTTPClient. Requests without a recorded response fail
+// with ErrNoFixture.
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(fixturePath(r.dir, req.URL))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w for %s", ErrNoFixture, req.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("invalid fixture for %s: %w", req.URL, err)
+	}
+
+	body := []byte(fixture.Body)
+	if fixture.Body == nil {
+		body = []byte(fixture.Text)
+	}
+	header := fixture.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.StatusCode, http.StatusText(fixture.StatusCode)),
+		StatusCode:    fixture.StatusCode,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// unsafeFixtureChars matches characters not allowed in fixture file names
+var unsafeFixtureChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// fixturePath returns the fixture file for u, mirroring the host and path
+// so recordings are easy to browse, e.g.
+// api-web.nhle.com/v1/gamecenter/2024020750/boxscore.json
+func fixturePath(dir string, u *url.URL) string {
+	segments := []string{unsafeFixtureChars.ReplaceAllString(u.Host, "_")}
+	for _, segment := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			continue
+		}
+		segments = append(segments, unsafeFixtureChars.ReplaceAllString(segment, "_"))
+	}
+	if len(segments) == 1 {
+		segments = append(segments, "index")
+	}
+
+	name := segments[len(segments)-1]
+	if u.RawQuery != "" {
+		name += "@" + unsafeFixtureChars.ReplaceAllString(u.RawQuery, "_")
+	}
+	segments[len(segments)-1] = name + ".json"
+
+	return filepath.Join(append([]string{dir}, segments...)...)
+}
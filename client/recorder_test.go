@@ -0,0 +1,98 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecorderReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/standings/now":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"standings":[{"teamAbbrev":{"default":"NYR"},"points":70}]}`))
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	recorder, err := NewRecorder(dir, server.Client())
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	ctx := context.Background()
+
+	// Record a success and a failure against the live server
+	live := NewClient(WithBaseURL(server.URL+"/v1"), WithHTTPClient(recorder), WithRetryPolicy(NoRetry))
+	recorded, err := live.GetStandings(ctx)
+	if err != nil {
+		t.Fatalf("GetStandings() error = %v", err)
+	}
+	if _, err := live.GetTeamRoster(ctx, "NYR"); err == nil {
+		t.Fatal("GetTeamRoster() should fail against the recording server")
+	}
+	server.Close()
+
+	// Replay them with the server gone
+	offline := NewClient(WithBaseURL(server.URL+"/v1"), WithHTTPClient(NewReplayer(dir)), WithRetryPolicy(NoRetry))
+	replayed, err := offline.GetStandings(ctx)
+	if err != nil {
+		t.Fatalf("replayed GetStandings() error = %v", err)
+	}
+	if len(replayed.Standings) != 1 || replayed.Standings[0].Points != recorded.Standings[0].Points {
+		t.Errorf("replayed standings = %+v, want %+v", replayed.Standings, recorded.Standings)
+	}
+	if _, err := offline.GetTeamRoster(ctx, "NYR"); err == nil {
+		t.Error("replayed GetTeamRoster() should return the recorded failure")
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/gamecenter/2024020750/boxscore", nil)
+	if _, err := NewReplayer(dir).Do(req); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("Do() without a recording error = %v, want ErrNoFixture", err)
+	}
+}
+
+func TestFixturePath(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{
+			url:  "https://api-web.nhle.com/v1/gamecenter/2024020750/boxscore",
+			want: "api-web.nhle.com/v1/gamecenter/2024020750/boxscore.json",
+		},
+		{
+			url:  "https://api-web.nhle.com/v1/score/2024-02-09?sort=desc",
+			want: "api-web.nhle.com/v1/score/2024-02-09@sort_desc.json",
+		},
+		{
+			url:  "https://forge-dapi.d3.nhle.com/v2/content/en-us/videos?tags.slug=gameid-2024020750",
+			want: "forge-dapi.d3.nhle.com/v2/content/en-us/videos@tags.slug_gameid-2024020750.json",
+		},
+		{
+			url:  "http://localhost:8080/../../etc/passwd",
+			want: "localhost_8080/etc/passwd.json",
+		},
+		{
+			url:  "http://localhost:8080/",
+			want: "localhost_8080/index.json",
+		},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatalf("url.Parse(%s) error = %v", tt.url, err)
+		}
+		if got := fixturePath("fixtures", u); got != filepath.Join("fixtures", tt.want) {
+			t.Errorf("fixturePath(%s) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
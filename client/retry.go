@@ -0,0 +1,122 @@
+package nhl
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Only idempotent requests (GET and HEAD) are ever retried.
+type RetryPolicy struct {
+	MaxAttempts     int           // Total attempts including the first; 1 or less disables retries
+	InitialBackoff  time.Duration // Wait before the first retry, doubled for each attempt after
+	MaxBackoff      time.Duration // Upper bound on the computed backoff
+	MaxElapsed      time.Duration // Overall budget across all attempts; 0 means no limit
+	Jitter          float64       // Fraction of each backoff that is randomized (0-1)
+	RetryableStatus []int         // Status codes that are retried
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient.
+// It retries throttling and gateway errors up to three attempts.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		MaxElapsed:     30 * time.Second,
+		Jitter:         0.2,
+		RetryableStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// NoRetry is a retry policy that never retries
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// RetryEvent describes a failed attempt that is about to be retried
+type RetryEvent struct {
+	URL        string
+	Attempt    int           // The attempt that failed, starting at 1
+	StatusCode int           // Response status, 0 for transport errors
+	Err        error         // Error from the failed attempt
+	Wait       time.Duration // Time until the next attempt
+}
+
+// RetryHook is called before each retry
+type RetryHook func(RetryEvent)
+
+// WithRetryPolicy sets the retry policy for failed requests
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRetryHook sets a hook called before each retry
+func WithRetryHook(hook RetryHook) Option {
+	return func(c *Client) {
+		c.retryHook = hook
+	}
+}
+
+// retryable reports whether a response status should be retried
+func (p RetryPolicy) retryable(statusCode int) bool {
+	return slices.Contains(p.RetryableStatus, statusCode)
+}
+
+// backoff returns the wait before retrying after the given attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait -= time.Duration(float64(wait) * p.Jitter * rand.Float64())
+	}
+	return wait
+}
+
+// isIdempotent reports whether a request can safely be retried
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,204 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClientGetRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		policy       RetryPolicy
+		wantErr      bool
+		wantAttempts int
+	}{
+		{
+			name:         "Retries transient errors until success",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			policy:       testRetryPolicy(3),
+			wantErr:      false,
+			wantAttempts: 3,
+		},
+		{
+			name:         "Gives up after max attempts",
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			policy:       testRetryPolicy(2),
+			wantErr:      true,
+			wantAttempts: 2,
+		},
+		{
+			name:         "Does not retry non-retryable status",
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			policy:       testRetryPolicy(3),
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "No retry policy",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			policy:       NoRetry,
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			var events []RetryEvent
+			client := NewClient(
+				WithRetryPolicy(tt.policy),
+				WithRetryHook(func(e RetryEvent) { events = append(events, e) }),
+				WithHTTPClient(&mockHTTPClient{
+					DoFunc: func(req *http.Request) (*http.Response, error) {
+						status := tt.statuses[attempts]
+						attempts++
+						return mockResponse(status, map[string]string{"field": "value"})
+					},
+				}),
+			)
+
+			var result map[string]string
+			err := client.get(context.Background(), "https://api.example.com/test", &result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("get() made %d attempts, want %d", attempts, tt.wantAttempts)
+			}
+			if len(events) != tt.wantAttempts-1 {
+				t.Errorf("retry hook called %d times, want %d", len(events), tt.wantAttempts-1)
+			}
+			for i, e := range events {
+				if e.Attempt != i+1 {
+					t.Errorf("retry event %d has attempt %d, want %d", i, e.Attempt, i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestClientGetRetryAfter(t *testing.T) {
+	attempts := 0
+	var waits []time.Duration
+	policy := testRetryPolicy(2)
+	policy.InitialBackoff = time.Hour
+	policy.MaxElapsed = 0
+
+	client := NewClient(
+		WithRetryPolicy(policy),
+		WithRetryHook(func(e RetryEvent) { waits = append(waits, e.Wait) }),
+		WithHTTPClient(&mockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts == 1 {
+					resp, err := mockResponse(http.StatusTooManyRequests, nil)
+					resp.Header = http.Header{"Retry-After": []string{"0"}}
+					return resp, err
+				}
+				return mockResponse(http.StatusOK, map[string]string{})
+			},
+		}),
+	)
+
+	var result map[string]string
+	if err := client.get(context.Background(), "https://api.example.com/test", &result); err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if len(waits) != 1 || waits[0] != 0 {
+		t.Errorf("retry waits = %v, want [0s] from Retry-After", waits)
+	}
+}
+
+func TestClientGetRetryBudget(t *testing.T) {
+	attempts := 0
+	policy := testRetryPolicy(5)
+	policy.InitialBackoff = time.Minute
+	policy.MaxElapsed = time.Second
+
+	client := NewClient(
+		WithRetryPolicy(policy),
+		WithHTTPClient(&mockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				return mockResponse(http.StatusServiceUnavailable, nil)
+			},
+		}),
+	)
+
+	var result map[string]string
+	if err := client.get(context.Background(), "https://api.example.com/test", &result); err == nil {
+		t.Error("get() should fail when the retry budget is exhausted")
+	}
+	if attempts != 1 {
+		t.Errorf("get() made %d attempts, want 1", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 2, 9, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "Seconds", value: "120", want: 2 * time.Minute, wantOK: true},
+		{name: "HTTP date", value: "Fri, 09 Feb 2024 20:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		{name: "Past HTTP date", value: "Fri, 09 Feb 2024 19:00:00 GMT", want: 0, wantOK: true},
+		{name: "Empty", value: "", want: 0, wantOK: false},
+		{name: "Invalid", value: "soon", want: 0, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value, now)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+
+	want := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, w := range want {
+		if got := policy.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for attempt := 1; attempt <= 5; attempt++ {
+		got := policy.backoff(attempt)
+		max := want[attempt-1]
+		if got > max || got < max/2 {
+			t.Errorf("backoff(%d) with jitter = %v, want between %v and %v", attempt, got, max/2, max)
+		}
+	}
+}
+
+// testRetryPolicy returns a retry policy with no waiting between attempts
+func testRetryPolicy(maxAttempts int) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.InitialBackoff = 0
+	policy.Jitter = 0
+	return policy
+}
@@ -0,0 +1,168 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Team game stat categories in GameRightRail.TeamGameStats
+const (
+	TeamStatShotsOnGoal   = "sog"
+	TeamStatFaceoffPctg   = "faceoffWinningPctg"
+	TeamStatPowerPlay     = "powerPlay" // goals/opportunities, such as 1/3
+	TeamStatPowerPlayPctg = "powerPlayPctg"
+	TeamStatPIM           = "pim"
+	TeamStatHits          = "hits"
+	TeamStatBlockedShots  = "blockedShots"
+	TeamStatGiveaways     = "giveaways"
+	TeamStatTakeaways     = "takeaways"
+)
+
+// GameRightRail is the side panel of a game's landing page: the season
+// series between the teams, their stats side by side, the linescore, the
+// officials, coaches and scratches, and each team's last 10 games
+type GameRightRail struct {
+	SeasonSeries     []SeasonSeriesGame   `json:"seasonSeries"`
+	SeasonSeriesWins SeasonSeriesWins     `json:"seasonSeriesWins"`
+	GameInfo         GameInfo             `json:"gameInfo"`
+	Linescore        Linescore            `json:"linescore"`
+	ShotsByPeriod    []PeriodScore        `json:"shotsByPeriod"`
+	TeamGameStats    []TeamStatComparison `json:"teamGameStats"`
+	Last10Record     *Last10Records       `json:"last10Record,omitempty"`
+}
+
+// SeasonSeriesGame is one of the season's games between the two teams
+type SeasonSeriesGame struct {
+	ID               GameID           `json:"id"`
+	Season           int              `json:"season"`
+	GameType         GameType         `json:"gameType"`
+	GameDate         string           `json:"gameDate"`
+	StartTimeUTC     string           `json:"startTimeUTC"`
+	GameState        GameState        `json:"gameState"`
+	AwayTeam         Team             `json:"awayTeam"`
+	HomeTeam         Team             `json:"homeTeam"`
+	PeriodDescriptor PeriodDescriptor `json:"periodDescriptor"`
+	GameOutcome      *GameOutcome     `json:"gameOutcome,omitempty"`
+}
+
+// SeasonSeriesWins is how many season series games each team has won
+type SeasonSeriesWins struct {
+	AwayTeamWins int `json:"awayTeamWins"`
+	HomeTeamWins int `json:"homeTeamWins"`
+}
+
+// GameInfo is the officials, coaches and scratches for a game
+type GameInfo struct {
+	Referees []LanguageNames `json:"referees"`
+	Linesmen []LanguageNames `json:"linesmen"`
+	AwayTeam GameInfoTeam    `json:"awayTeam"`
+	HomeTeam GameInfoTeam    `json:"homeTeam"`
+}
+
+// GameInfoTeam is a team's head coach and scratched players for a game
+type GameInfoTeam struct {
+	HeadCoach LanguageNames `json:"headCoach"`
+	Scratches []Scratch     `json:"scratches"`
+}
+
+// Scratch is a player left out of a game's lineup
+type Scratch struct {
+	ID        int           `json:"id"`
+	FirstName LanguageNames `json:"firstName"`
+	LastName  LanguageNames `json:"lastName"`
+}
+
+// Linescore is a game's goals by period
+type Linescore struct {
+	ByPeriod []PeriodScore `json:"byPeriod"`
+	Totals   struct {
+		Away int `json:"away"`
+		Home int `json:"home"`
+	} `json:"totals"`
+}
+
+// PeriodScore is a count, such as goals or shots, for each team in a period
+type PeriodScore struct {
+	PeriodDescriptor PeriodDescriptor `json:"periodDescriptor"`
+	Away             int              `json:"away"`
+	Home             int              `json:"home"`
+}
+
+// TeamStatComparison is one team stat for both teams in a game
+type TeamStatComparison struct {
+	Category  string    `json:"category"`
+	AwayValue StatValue `json:"awayValue"`
+	HomeValue StatValue `json:"homeValue"`
+}
+
+// StatValue is a team game stat, which the API sends as either a number or
+// a string such as "1/3"
+type StatValue string
+
+// UnmarshalJSON accepts a JSON number or string
+func (v *StatValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*v = StatValue(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("stat value %s is neither a number nor a string", data)
+	}
+	*v = StatValue(n.String())
+	return nil
+}
+
+// Float returns the value as a number, or 0 when it is not one
+func (v StatValue) Float() float64 {
+	f, _ := strconv.ParseFloat(strings.TrimSpace(string(v)), 64)
+	return f
+}
+
+// Last10Records is each team's results over its last 10 games
+type Last10Records struct {
+	AwayTeam Last10Record `json:"awayTeam"`
+	HomeTeam Last10Record `json:"homeTeam"`
+}
+
+// Last10Record is a team's results over its last 10 games
+type Last10Record struct {
+	Record          string           `json:"record"` // wins-losses-OT losses
+	StreakType      string           `json:"streakType"`
+	Streak          int              `json:"streak"`
+	PastGameResults []PastGameResult `json:"pastGameResults"`
+}
+
+// PastGameResult is the result of one of a team's recent games
+type PastGameResult struct {
+	OpponentAbbrev string `json:"opponentAbbrev"`
+	GameResult     string `json:"gameResult"` // W, L or O
+}
+
+// GetGameRightRail returns the right rail of a game's landing page
+func (c *Client) GetGameRightRail(ctx context.Context, gameID GameID) (*GameRightRail, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+
+	url := fmt.Sprintf("%s/gamecenter/%d/right-rail", c.baseURL, gameID)
+	var response GameRightRail
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get game right rail: %w", err)
+	}
+	return &response, nil
+}
+
+// Stat returns a team game stat by category, such as TeamStatHits
+func (r *GameRightRail) Stat(category string) (TeamStatComparison, bool) {
+	for _, stat := range r.TeamGameStats {
+		if stat.Category == category {
+			return stat, true
+		}
+	}
+	return TeamStatComparison{}, false
+}
@@ -0,0 +1,63 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetGameRightRail(t *testing.T) {
+	var path string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			path = req.URL.Path
+			return mockResponse(http.StatusOK, map[string]any{
+				"seasonSeriesWins": map[string]int{"awayTeamWins": 2, "homeTeamWins": 1},
+				"teamGameStats": []map[string]any{
+					{"category": "sog", "awayValue": 33, "homeValue": 27},
+					{"category": "faceoffWinningPctg", "awayValue": 0.534483, "homeValue": 0.465517},
+					{"category": "powerPlay", "awayValue": "1/2", "homeValue": "0/3"},
+				},
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	rail, err := client.GetGameRightRail(context.Background(), 2024020750)
+	if err != nil {
+		t.Fatalf("GetGameRightRail() error = %v", err)
+	}
+	if path != "/v1/gamecenter/2024020750/right-rail" {
+		t.Errorf("GetGameRightRail() requested %s", path)
+	}
+	if rail.SeasonSeriesWins.AwayTeamWins != 2 {
+		t.Errorf("SeasonSeriesWins = %+v", rail.SeasonSeriesWins)
+	}
+
+	tests := []struct {
+		category  string
+		away      StatValue
+		awayFloat float64
+	}{
+		{category: TeamStatShotsOnGoal, away: "33", awayFloat: 33},
+		{category: TeamStatFaceoffPctg, away: "0.534483", awayFloat: 0.534483},
+		{category: TeamStatPowerPlay, away: "1/2", awayFloat: 0},
+	}
+	for _, tt := range tests {
+		stat, ok := rail.Stat(tt.category)
+		if !ok {
+			t.Errorf("Stat(%s) not found", tt.category)
+			continue
+		}
+		if stat.AwayValue != tt.away || stat.AwayValue.Float() != tt.awayFloat {
+			t.Errorf("Stat(%s).AwayValue = %q (%v), want %q (%v)", tt.category, stat.AwayValue, stat.AwayValue.Float(), tt.away, tt.awayFloat)
+		}
+	}
+	if _, ok := rail.Stat(TeamStatHits); ok {
+		t.Error("Stat() found a category the game does not have")
+	}
+
+	if _, err := client.GetGameRightRail(context.Background(), 0); err == nil {
+		t.Error("GetGameRightRail() with an invalid game ID returned no error")
+	}
+}
@@ -1,29 +1,33 @@
 package nhl
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 )
 
 // GetCurrentSchedule returns the schedule for the current day
-func (c *Client) GetCurrentSchedule() (*FilteredScoreboardResponse, error) {
+func (c *Client) GetCurrentSchedule(ctx context.Context) (*FilteredScoreboardResponse, error) {
 	today := time.Now().Format("2006-01-02")
-	return c.GetScheduleByDate(today, SortByDateAsc)
+	return c.GetScheduleByDate(ctx, today, SortByDateAsc)
 }
 
 // GetScheduleByDate returns the schedule for a specific date
 // date should be in YYYY-MM-DD format
 // sortOrder can be either SortByDateAsc or SortByDateDesc
-func (c *Client) GetScheduleByDate(date string, sortOrder SortOrder) (*FilteredScoreboardResponse, error) {
+func (c *Client) GetScheduleByDate(ctx context.Context, date string, sortOrder SortOrder) (*FilteredScoreboardResponse, error) {
 	url := fmt.Sprintf("%s/score/%s", c.baseURL, date)
 	if sortOrder == SortByDateDesc {
 		url += "?sort=desc"
 	}
 
 	var response FilteredScoreboardResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get schedule: %v", err)
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
 	}
 
 	// Set the date in the response
@@ -33,7 +37,7 @@ func (c *Client) GetScheduleByDate(date string, sortOrder SortOrder) (*FilteredS
 }
 
 // GetTeamSchedule returns the schedule for a specific team and season
-func (c *Client) GetTeamSchedule(team *TeamInfo, seasonID int) (*TeamScheduleResponse, error) {
+func (c *Client) GetTeamSchedule(ctx context.Context, team *TeamInfo, seasonID int) (*TeamScheduleResponse, error) {
 	if team == nil {
 		return nil, fmt.Errorf("team cannot be nil")
 	}
@@ -45,10 +49,171 @@ func (c *Client) GetTeamSchedule(team *TeamInfo, seasonID int) (*TeamScheduleRes
 	url := fmt.Sprintf("%s/club-schedule-season/%s/%d", c.baseURL, team.Abbreviation, seasonID)
 
 	var response TeamScheduleResponse
-	err := c.get(url, &response)
+	err := c.get(ctx, url, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get team schedule: %v", err)
+		return nil, fmt.Errorf("failed to get team schedule: %w", err)
 	}
 
 	return &response, nil
 }
+
+// MaxScheduleDays is the longest date range GetLeagueSchedule will walk
+const MaxScheduleDays = 366
+
+// HomeAway limits a schedule filter to home or away games of its teams
+type HomeAway string
+
+const (
+	HomeOrAway HomeAway = ""
+	HomeOnly   HomeAway = "home"
+	AwayOnly   HomeAway = "away"
+)
+
+// ParseHomeAway parses "home", "away" or "" (either)
+func ParseHomeAway(s string) (HomeAway, error) {
+	switch h := HomeAway(strings.ToLower(strings.TrimSpace(s))); h {
+	case HomeOrAway, HomeOnly, AwayOnly:
+		return h, nil
+	}
+	return "", fmt.Errorf("invalid home/away value %q, want home or away", s)
+}
+
+// ScheduleFilter picks games out of the league schedule. Empty fields match
+// every game.
+type ScheduleFilter struct {
+	Teams      []string    // team abbreviations such as NYR
+	HomeAway   HomeAway    // whether Teams must be the home or away side
+	GameTypes  []GameType  // such as GameTypeRegularSeason
+	GameStates []GameState // such as GameStateFuture or GameStateLive
+
+	// Broadcasts keeps games carried by a matching broadcast, trimming
+	// their TVBroadcasts to those broadcasts
+	Broadcasts *BroadcastFilter
+}
+
+// Matches reports whether game passes the filter. A nil filter matches
+// every game.
+func (f *ScheduleFilter) Matches(game Game) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Teams) > 0 {
+		home := containsFold(f.Teams, game.HomeTeam.Abbrev)
+		away := containsFold(f.Teams, game.AwayTeam.Abbrev)
+		switch f.HomeAway {
+		case HomeOnly:
+			if !home {
+				return false
+			}
+		case AwayOnly:
+			if !away {
+				return false
+			}
+		default:
+			if !home && !away {
+				return false
+			}
+		}
+	}
+	if len(f.GameTypes) > 0 && !slices.Contains(f.GameTypes, game.GameType) {
+		return false
+	}
+	if len(f.GameStates) > 0 && !slices.Contains(f.GameStates, game.GameState) {
+		return false
+	}
+	if !f.Broadcasts.IsZero() && len(f.Broadcasts.Broadcasts(game.TVBroadcasts)) == 0 {
+		return false
+	}
+	return true
+}
+
+// containsFold reports whether list holds s, ignoring case
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// LeagueSchedule is every game played across the league in a date range,
+// grouped by date. Days without games are left out.
+type LeagueSchedule struct {
+	StartDate string    `json:"startDate"`
+	EndDate   string    `json:"endDate"`
+	GameDays  []GameDay `json:"gameDays"`
+}
+
+// Games returns every game in the schedule in date order
+func (s *LeagueSchedule) Games() []Game {
+	var games []Game
+	for _, day := range s.GameDays {
+		games = append(games, day.Games...)
+	}
+	return games
+}
+
+// GetLeagueSchedule returns the league's games from start to end inclusive,
+// both in YYYY-MM-DD format, walking the weekly schedule a week at a time.
+// Games appearing in more than one week are only returned once, and filter,
+// which may be nil, picks which games are kept.
+func (c *Client) GetLeagueSchedule(ctx context.Context, start, end string, filter *ScheduleFilter) (*LeagueSchedule, error) {
+	from, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %q: %w", start, err)
+	}
+	to, err := time.Parse("2006-01-02", end)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date %q: %w", end, err)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("end date %s is before start date %s", end, start)
+	}
+	if to.Sub(from) >= MaxScheduleDays*24*time.Hour {
+		return nil, fmt.Errorf("date range %s to %s is longer than %d days", start, end, MaxScheduleDays)
+	}
+
+	seen := make(map[GameID]bool)
+	days := make(map[string][]Game)
+	for week := from; !week.After(to); {
+		url := fmt.Sprintf("%s/schedule/%s", c.baseURL, week.Format("2006-01-02"))
+		var response Schedule
+		if err := c.get(ctx, url, &response); err != nil {
+			return nil, fmt.Errorf("failed to get schedule for week of %s: %w", week.Format("2006-01-02"), err)
+		}
+
+		for _, day := range response.GameWeek {
+			if day.Date < start || day.Date > end {
+				continue
+			}
+			for _, game := range day.Games {
+				if seen[game.ID] || !filter.Matches(game) {
+					continue
+				}
+				seen[game.ID] = true
+				if filter != nil && !filter.Broadcasts.IsZero() {
+					game.TVBroadcasts = filter.Broadcasts.Broadcasts(game.TVBroadcasts)
+				}
+				days[day.Date] = append(days[day.Date], game)
+			}
+		}
+
+		// The API skips ahead over weeks without games, such as the
+		// off-season, so follow it rather than always adding a week
+		next := week.AddDate(0, 0, 7)
+		if t, err := time.Parse("2006-01-02", response.NextStartDate); err == nil && t.After(week) {
+			next = t
+		}
+		week = next
+	}
+
+	schedule := &LeagueSchedule{StartDate: start, EndDate: end, GameDays: []GameDay{}}
+	for date, games := range days {
+		schedule.GameDays = append(schedule.GameDays, GameDay{Date: date, Games: games})
+	}
+	sort.Slice(schedule.GameDays, func(i, j int) bool {
+		return schedule.GameDays[i].Date < schedule.GameDays[j].Date
+	})
+	return schedule, nil
+}
@@ -1,31 +1,33 @@
 package nhl_test
 
 import (
+	"context"
 	"go-nhl/client"
 	"testing"
 )
 
 func TestGetTeamSchedule(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
-	team, err := client.GetTeamByIdentifier("TOR")
+	team, err := client.GetTeamByIdentifier(ctx, "TOR")
 	if err != nil {
 		t.Fatalf("Failed to get team: %v", err)
 	}
 
 	// Test with nil team
-	_, err = client.GetTeamSchedule(nil, 20232024)
+	_, err = client.GetTeamSchedule(ctx, nil, 20232024)
 	if err == nil {
 		t.Error("GetTeamSchedule() with nil team should return error")
 	}
 
 	// Test with valid team but invalid season
-	_, err = client.GetTeamSchedule(team, -1)
+	_, err = client.GetTeamSchedule(ctx, team, -1)
 	if err == nil {
 		t.Error("GetTeamSchedule() with invalid season should return error")
 	}
 
 	// Test with valid team and season
-	schedule, err := client.GetTeamSchedule(team, 20232024)
+	schedule, err := client.GetTeamSchedule(ctx, team, 20232024)
 	if err != nil {
 		t.Errorf("GetTeamSchedule() error = %v", err)
 		return
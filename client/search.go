@@ -0,0 +1,416 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// DefaultSearchLimit is the number of results SearchPlayers returns when
+// no limit is given
+const DefaultSearchLimit = 10
+
+// PlayerIndexTTL is how long the local player index is kept in the
+// client's cache between searches
+const PlayerIndexTTL = 30 * 24 * time.Hour
+
+// playerIndexKey is the cache key of the local player index
+const playerIndexKey = "nhl:player-index"
+
+// minFuzzyScore is the lowest similarity a fuzzy match needs to be returned
+const minFuzzyScore = 0.75
+
+// PlayerSearchOptions narrows a player search
+type PlayerSearchOptions struct {
+	ActiveOnly bool     // Only players currently on an NHL roster
+	Position   Position // Position code: C, L, R, D or G. PositionForward matches any forward.
+	Team       string   // Team abbreviation, matching the current or last team
+	Limit      int      // Maximum results, DefaultSearchLimit when zero
+}
+
+// SearchPlayer searches for players by name, current and retired
+func (c *Client) SearchPlayer(ctx context.Context, name string) ([]PlayerSearchResult, error) {
+	return c.SearchPlayers(ctx, name, PlayerSearchOptions{})
+}
+
+// SearchPlayers searches the NHL player search API and the local player
+// index for players matching query. Matching ignores case and accents,
+// tolerates small typos, and ranks the closest names first. When the search
+// API is unavailable the local index of previously seen players is used.
+func (c *Client) SearchPlayers(ctx context.Context, query string, opts PlayerSearchOptions) ([]PlayerSearchResult, error) {
+	folded := foldName(query)
+	if folded == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	found, err := c.searchPlayerAPI(ctx, folded, opts.ActiveOnly)
+	if err == nil && len(found) == 0 {
+		// Widen the search to the start of the last word so typos and
+		// spelling variants still find candidates to rank
+		words := strings.Fields(folded)
+		if prefix := []rune(words[len(words)-1]); len(prefix) > 4 {
+			found, err = c.searchPlayerAPI(ctx, string(prefix[:4]), opts.ActiveOnly)
+		}
+	}
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	index := c.playerIndex()
+	if err == nil {
+		index.add(found)
+	} else if index.len() == 0 {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+
+	results := index.search(folded, opts)
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	if err := c.addBirthYears(ctx, results); err != nil {
+		return nil, err
+	}
+	c.savePlayerIndex(index)
+
+	return results, nil
+}
+
+// searchResponse is a player returned by the NHL player search API
+type searchResponse struct {
+	PlayerID       flexInt  `json:"playerId"`
+	Name           string   `json:"name"`
+	PositionCode   Position `json:"positionCode"`
+	TeamID         flexInt  `json:"teamId"`
+	TeamAbbrev     string   `json:"teamAbbrev"`
+	LastTeamID     flexInt  `json:"lastTeamId"`
+	LastTeamAbbrev string   `json:"lastTeamAbbrev"`
+	LastSeasonID   flexInt  `json:"lastSeasonId"`
+	SweaterNumber  flexInt  `json:"sweaterNumber"`
+	Active         bool     `json:"active"`
+	BirthCountry   string   `json:"birthCountry"`
+}
+
+// searchPlayerAPI queries the NHL player search API
+func (c *Client) searchPlayerAPI(ctx context.Context, query string, activeOnly bool) ([]PlayerSearchResult, error) {
+	params := url.Values{}
+	params.Set("culture", "en-us")
+	params.Set("limit", "50")
+	params.Set("q", query)
+	if activeOnly {
+		params.Set("active", "true")
+	}
+
+	var response []searchResponse
+	if err := c.get(ctx, c.searchBaseURL+"/search/player?"+params.Encode(), &response); err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+
+	results := make([]PlayerSearchResult, 0, len(response))
+	for _, player := range response {
+		first, last, _ := strings.Cut(player.Name, " ")
+		results = append(results, PlayerSearchResult{
+			FirstName:      NameInfo{Default: first},
+			LastName:       NameInfo{Default: last},
+			Position:       player.PositionCode,
+			JerseyNumber:   int(player.SweaterNumber),
+			TeamID:         int(player.TeamID),
+			TeamAbbrev:     player.TeamAbbrev,
+			PlayerID:       int(player.PlayerID),
+			Active:         player.Active,
+			LastTeamID:     int(player.LastTeamID),
+			LastTeamAbbrev: player.LastTeamAbbrev,
+			LastSeasonID:   int(player.LastSeasonID),
+			BirthCountry:   player.BirthCountry,
+		})
+	}
+	return results, nil
+}
+
+// addBirthYears fills in birth years the index does not know yet from the
+// players' landing pages. Players whose page cannot be loaded are left as is.
+func (c *Client) addBirthYears(ctx context.Context, results []PlayerSearchResult) error {
+	var wg sync.WaitGroup
+	for i := range results {
+		if results[i].BirthYear != 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(player *PlayerSearchResult) {
+			defer wg.Done()
+			var landing struct {
+				BirthDate string `json:"birthDate"`
+			}
+			url := fmt.Sprintf("%s/player/%d/landing", c.baseURL, player.PlayerID)
+			if err := c.get(ctx, url, &landing); err != nil {
+				return
+			}
+			if birth, err := time.Parse("2006-01-02", landing.BirthDate); err == nil {
+				player.BirthYear = birth.Year()
+			}
+		}(&results[i])
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.playerIndex().add(results)
+	return nil
+}
+
+// playerIndex returns the local player index, loading it from the cache
+// on first use
+func (c *Client) playerIndex() *playerIndex {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.players == nil {
+		c.players = &playerIndex{players: make(map[int]PlayerSearchResult)}
+		if c.cache != nil {
+			if data, ok := c.cache.Get(playerIndexKey); ok {
+				var players []PlayerSearchResult
+				if err := json.Unmarshal(data, &players); err == nil {
+					c.players.add(players)
+				}
+			}
+		}
+	}
+	return c.players
+}
+
+// savePlayerIndex stores the local player index in the client's cache
+func (c *Client) savePlayerIndex(index *playerIndex) {
+	if c.cache == nil {
+		return
+	}
+	data, err := json.Marshal(index.all())
+	if err != nil {
+		return
+	}
+	c.cache.Set(playerIndexKey, data, PlayerIndexTTL)
+}
+
+// playerIndex holds every player seen in search results, so players can be
+// found again without the search API
+type playerIndex struct {
+	mu      sync.RWMutex
+	players map[int]PlayerSearchResult
+}
+
+// add adds or updates players, keeping known birth years
+func (x *playerIndex) add(players []PlayerSearchResult) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, player := range players {
+		if player.BirthYear == 0 {
+			player.BirthYear = x.players[player.PlayerID].BirthYear
+		}
+		x.players[player.PlayerID] = player
+	}
+}
+
+// len returns the number of indexed players
+func (x *playerIndex) len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.players)
+}
+
+// all returns every indexed player
+func (x *playerIndex) all() []PlayerSearchResult {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	players := make([]PlayerSearchResult, 0, len(x.players))
+	for _, player := range x.players {
+		players = append(players, player)
+	}
+	return players
+}
+
+// search returns the indexed players matching the folded query and opts,
+// best matches first
+func (x *playerIndex) search(query string, opts PlayerSearchOptions) []PlayerSearchResult {
+	type match struct {
+		player PlayerSearchResult
+		score  float64
+	}
+
+	var matches []match
+	for _, player := range x.all() {
+		if !player.matches(opts) {
+			continue
+		}
+		if score := matchScore(query, player); score > 0 {
+			matches = append(matches, match{player, score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.player.Active != b.player.Active {
+			return a.player.Active
+		}
+		if a.player.LastSeasonID != b.player.LastSeasonID {
+			return a.player.LastSeasonID > b.player.LastSeasonID
+		}
+		return a.player.PlayerID > b.player.PlayerID
+	})
+
+	results := make([]PlayerSearchResult, len(matches))
+	for i, m := range matches {
+		results[i] = m.player
+	}
+	return results
+}
+
+// matches reports whether the player passes the filters in opts
+func (p PlayerSearchResult) matches(opts PlayerSearchOptions) bool {
+	if opts.ActiveOnly && !p.Active {
+		return false
+	}
+	if position := normalizePosition(string(opts.Position)); position != "" && !position.Matches(p.Position) {
+		return false
+	}
+	if team := strings.ToUpper(opts.Team); team != "" && team != p.TeamAbbrev && team != p.LastTeamAbbrev {
+		return false
+	}
+	return true
+}
+
+// matchScore rates how well a player's name matches the folded query,
+// from 1 for an exact match down to 0 for no match
+func matchScore(query string, player PlayerSearchResult) float64 {
+	first := foldName(player.FirstName.Default)
+	last := foldName(player.LastName.Default)
+	full := strings.TrimSpace(first + " " + last)
+
+	switch {
+	case query == full:
+		return 1
+	case query == last:
+		return 0.95
+	case strings.HasPrefix(full, query):
+		return 0.9
+	case strings.HasPrefix(last, query):
+		return 0.85
+	case strings.Contains(full, query):
+		return 0.7
+	}
+
+	// Fuzzy match the query against the whole name, or each query word
+	// against the best name word
+	best := similarity(query, full)
+	words := strings.Fields(full)
+	for _, word := range strings.Fields(query) {
+		for _, name := range words {
+			best = max(best, similarity(word, name))
+		}
+	}
+	if best < minFuzzyScore {
+		return 0
+	}
+	return 0.6 * best
+}
+
+// similarity returns 1 minus the edit distance between a and b relative to
+// the longer of the two
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(ra, rb))/float64(longest)
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// foldName lowercases name, strips accents and collapses punctuation and
+// spacing, so "Stützle" and "stutzle" compare equal
+func foldName(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		if folded, ok := foldedRunes[r]; ok {
+			b.WriteString(folded)
+			space = false
+			continue
+		}
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			space = false
+		case r == '\'' || r == '’' || r == '.':
+			// Drop apostrophes and periods: "O'Reilly", "St. Louis"
+		case !space && b.Len() > 0:
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// foldedRunes maps accented letters found in player names to plain ASCII
+var foldedRunes = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ą': "a", 'ă': "a",
+	'æ': "ae", 'ç': "c", 'č': "c", 'ć': "c", 'ď': "d", 'đ': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ę': "e", 'ě': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i",
+	'ľ': "l", 'ĺ': "l", 'ł': "l", 'ñ': "n", 'ń': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ő': "o", 'œ': "oe",
+	'ŕ': "r", 'ř': "r", 'ś': "s", 'š': "s", 'ş': "s", 'ß': "ss", 'ť': "t", 'ţ': "t",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ů': "u", 'ű': "u",
+	'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// flexInt decodes an integer sent either as a JSON number or a string,
+// treating null and empty strings as zero
+type flexInt int
+
+// UnmarshalJSON implements json.Unmarshaler
+func (n *flexInt) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s: %w", data, err)
+	}
+	*n = flexInt(v)
+	return nil
+}
@@ -0,0 +1,160 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFoldName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Tim Stützle", "tim stutzle"},
+		{"Juraj Slafkovský", "juraj slafkovsky"},
+		{"  Ryan  O'Reilly ", "ryan oreilly"},
+		{"Jean-Gabriel Pageau", "jean gabriel pageau"},
+		{"Martin St. Louis", "martin st louis"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := foldName(tt.name); got != tt.want {
+			t.Errorf("foldName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPlayerIndexSearch(t *testing.T) {
+	index := &playerIndex{players: make(map[int]PlayerSearchResult)}
+	index.add([]PlayerSearchResult{
+		searchResult(1, "Tim", "Stützle", "C", "OTT", true),
+		searchResult(2, "Juraj", "Slafkovský", "L", "MTL", true),
+		searchResult(3, "Jaromír", "Jágr", "R", "", false),
+		searchResult(4, "Brady", "Tkachuk", "L", "OTT", true),
+		searchResult(5, "Matthew", "Tkachuk", "L", "FLA", true),
+		searchResult(6, "Keith", "Tkachuk", "L", "", false),
+	})
+
+	tests := []struct {
+		name  string
+		query string
+		opts  PlayerSearchOptions
+		want  []int
+	}{
+		{name: "Accents", query: "stutzle", want: []int{1}},
+		{name: "Typo", query: "slafkovksy", want: []int{2}},
+		{name: "Retired", query: "jagr", want: []int{3}},
+		{name: "Full name first", query: "matthew tkachuk", want: []int{5, 4, 6}},
+		{name: "Active before retired", query: "tkachuk", want: []int{5, 4, 6}},
+		{name: "Active only", query: "tkachuk", opts: PlayerSearchOptions{ActiveOnly: true}, want: []int{5, 4}},
+		{name: "Team", query: "tkachuk", opts: PlayerSearchOptions{Team: "ott"}, want: []int{4}},
+		{name: "Forwards", query: "stutzle", opts: PlayerSearchOptions{Position: "F"}, want: []int{1}},
+		{name: "Position", query: "stutzle", opts: PlayerSearchOptions{Position: "D"}, want: nil},
+		{name: "No match", query: "gretzky", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, player := range index.search(foldName(tt.query), tt.opts) {
+				got = append(got, player.PlayerID)
+			}
+			if !equalInts(got, tt.want) {
+				t.Errorf("search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPlayersIndexFallback(t *testing.T) {
+	cache := NewMemoryCache(10)
+	available := true
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if !available {
+				return mockResponse(http.StatusServiceUnavailable, nil)
+			}
+			if strings.Contains(req.URL.Path, "/landing") {
+				return mockResponse(http.StatusOK, map[string]string{"birthDate": "2002-01-15"})
+			}
+			return mockResponse(http.StatusOK, []map[string]any{
+				{"playerId": "8482116", "name": "Tim Stützle", "positionCode": "C", "teamId": "9", "teamAbbrev": "OTT", "sweaterNumber": 18, "active": true},
+			})
+		},
+	}
+	ctx := context.Background()
+
+	client := NewClient(WithCache(cache), WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+	players, err := client.SearchPlayers(ctx, "Stützle", PlayerSearchOptions{})
+	if err != nil {
+		t.Fatalf("SearchPlayers() error = %v", err)
+	}
+	if len(players) != 1 || players[0].PlayerID != 8482116 || players[0].TeamID != 9 || players[0].BirthYear != 2002 {
+		t.Fatalf("SearchPlayers() = %+v, want Stützle born 2002", players)
+	}
+
+	// A new client finds the player in the cached index while the API is down
+	available = false
+	offline := NewClient(WithCache(cache), WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+	players, err = offline.SearchPlayers(ctx, "stutzle", PlayerSearchOptions{})
+	if err != nil {
+		t.Fatalf("SearchPlayers() with the API down error = %v", err)
+	}
+	if len(players) != 1 || players[0].BirthYear != 2002 {
+		t.Errorf("SearchPlayers() with the API down = %+v, want Stützle from the index", players)
+	}
+
+	// Without an index the outage is reported
+	empty := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+	if _, err := empty.SearchPlayers(ctx, "stutzle", PlayerSearchOptions{}); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("SearchPlayers() without an index error = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestSearchPlayersWidensByRunes(t *testing.T) {
+	var queries []string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			queries = append(queries, req.URL.Query().Get("q"))
+			return mockResponse(http.StatusOK, []map[string]any{})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	if _, err := client.SearchPlayers(context.Background(), "Овечкин", PlayerSearchOptions{}); err != nil {
+		t.Fatalf("SearchPlayers() error = %v", err)
+	}
+	if len(queries) != 2 || queries[1] != "овеч" || !utf8.ValidString(queries[1]) {
+		t.Errorf("SearchPlayers() queries = %q, want the name then its first four letters", queries)
+	}
+}
+
+// searchResult returns a search result for the index tests
+func searchResult(id int, first, last, position, team string, active bool) PlayerSearchResult {
+	return PlayerSearchResult{
+		PlayerID:   id,
+		FirstName:  NameInfo{Default: first},
+		LastName:   NameInfo{Default: last},
+		Position:   Position(position),
+		TeamAbbrev: team,
+		Active:     active,
+	}
+}
+
+// equalInts reports whether a and b hold the same values in order
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
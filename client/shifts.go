@@ -0,0 +1,197 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Shift chart entry types
+const (
+	ShiftTypeShift = 517 // a player's shift on the ice
+	ShiftTypeGoal  = 505 // a goal, marked on the scorer's row
+)
+
+// Shift is one row of a game's shift chart: either a player's shift or,
+// when TypeCode is ShiftTypeGoal, a marker for a goal they scored
+type Shift struct {
+	ID               int    `json:"id"`
+	GameID           GameID `json:"gameId"`
+	PlayerID         int    `json:"playerId"`
+	FirstName        string `json:"firstName"`
+	LastName         string `json:"lastName"`
+	TeamID           int    `json:"teamId"`
+	TeamAbbrev       string `json:"teamAbbrev"`
+	TeamName         string `json:"teamName"`
+	Period           int    `json:"period"`
+	ShiftNumber      int    `json:"shiftNumber"`
+	StartTime        string `json:"startTime"` // MM:SS elapsed in the period
+	EndTime          string `json:"endTime"`   // MM:SS elapsed in the period
+	Duration         string `json:"duration"`  // MM:SS, empty for goals
+	TypeCode         int    `json:"typeCode"`
+	DetailCode       int    `json:"detailCode"`
+	EventNumber      int    `json:"eventNumber,omitempty"`      // play-by-play event ID of a goal
+	EventDescription string `json:"eventDescription,omitempty"` // strength of a goal, such as EVG or PPG
+	EventDetails     string `json:"eventDetails,omitempty"`     // assists on a goal
+	HexValue         string `json:"hexValue"`
+}
+
+// IsGoal reports whether the row marks a goal rather than a shift
+func (s Shift) IsGoal() bool {
+	return s.TypeCode == ShiftTypeGoal
+}
+
+// Start returns the seconds elapsed in the period when the shift began
+func (s Shift) Start() int {
+	seconds, _ := clockSeconds(s.StartTime)
+	return seconds
+}
+
+// End returns the seconds elapsed in the period when the shift ended
+func (s Shift) End() int {
+	seconds, _ := clockSeconds(s.EndTime)
+	return seconds
+}
+
+// ShiftChart is every shift played in a game
+type ShiftChart struct {
+	GameID GameID  `json:"gameId"`
+	Shifts []Shift `json:"shifts"`
+}
+
+// GetGameShifts returns a game's shift chart from the stats REST API, in
+// period and start time order. Goals are marked by rows whose IsGoal is
+// true.
+func (c *Client) GetGameShifts(ctx context.Context, gameID GameID) (*ShiftChart, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+
+	query := StatsQuery{Cayenne: Eq("gameId", gameID)}
+	url := fmt.Sprintf("%s/shiftcharts?%s", c.statsBaseURL, query.values().Encode())
+	var response struct {
+		Data  []Shift `json:"data"`
+		Total int     `json:"total"`
+	}
+	if err := c.get(ctx, url, &response); err != nil {
+		return nil, fmt.Errorf("failed to get shift chart: %w", err)
+	}
+
+	shifts := response.Data
+	sort.SliceStable(shifts, func(i, j int) bool {
+		if shifts[i].Period != shifts[j].Period {
+			return shifts[i].Period < shifts[j].Period
+		}
+		return shifts[i].Start() < shifts[j].Start()
+	})
+	return &ShiftChart{GameID: gameID, Shifts: shifts}, nil
+}
+
+// Player returns a player's shifts, in order
+func (c *ShiftChart) Player(playerID int) []Shift {
+	var shifts []Shift
+	for _, s := range c.Shifts {
+		if s.PlayerID == playerID && !s.IsGoal() {
+			shifts = append(shifts, s)
+		}
+	}
+	return shifts
+}
+
+// Goals returns the goal markers, in order
+func (c *ShiftChart) Goals() []Shift {
+	var goals []Shift
+	for _, s := range c.Shifts {
+		if s.IsGoal() {
+			goals = append(goals, s)
+		}
+	}
+	return goals
+}
+
+// OnIce returns the shifts of the players on the ice from timeInPeriod, a
+// MM:SS time elapsed in period. Players changing at that moment count as
+// the ones coming on, as they would for a faceoff.
+func (c *ShiftChart) OnIce(period int, timeInPeriod string) ([]Shift, error) {
+	t, err := clockSeconds(timeInPeriod)
+	if err != nil {
+		return nil, err
+	}
+	return c.onIce(period, t, true), nil
+}
+
+// onIce returns the shifts covering t seconds into period. When starting is
+// true players coming on at t are counted, otherwise players going off.
+func (c *ShiftChart) onIce(period, t int, starting bool) []Shift {
+	var shifts []Shift
+	for _, s := range c.Shifts {
+		if s.IsGoal() || s.Period != period {
+			continue
+		}
+		start, end := s.Start(), s.End()
+		if (starting && start <= t && t < end) || (!starting && start < t && t <= end) {
+			shifts = append(shifts, s)
+		}
+	}
+	return shifts
+}
+
+// PlayOnIce is a play-by-play event with the players on the ice for it
+type PlayOnIce struct {
+	Play PlayEvent    `json:"play"`
+	Home []RosterSpot `json:"home"`
+	Away []RosterSpot `json:"away"`
+}
+
+// OnIceForPlays joins the shift chart with a game's play-by-play, giving
+// the players on the ice for every event. Faceoffs and period starts count
+// players coming on at the event's time, and everything else, such as
+// goals and stoppages, the players going off. Players missing from the
+// play-by-play roster are left out, so events in periods without shifts,
+// such as shootouts, have nobody on the ice.
+func (c *ShiftChart) OnIceForPlays(pbp *PlayByPlayResponse) []PlayOnIce {
+	roster := make(map[int]RosterSpot, len(pbp.RosterSpots))
+	for _, spot := range pbp.RosterSpots {
+		roster[spot.PlayerID] = spot
+	}
+
+	plays := make([]PlayOnIce, 0, len(pbp.Plays))
+	for _, play := range pbp.Plays {
+		t, err := clockSeconds(play.TimeInPeriod)
+		if err != nil {
+			continue
+		}
+		starting := play.TypeDescKey == "faceoff" || play.TypeDescKey == "period-start"
+
+		onIce := PlayOnIce{Play: play, Home: []RosterSpot{}, Away: []RosterSpot{}}
+		for _, s := range c.onIce(play.PeriodDescriptor.Number, t, starting) {
+			spot, ok := roster[s.PlayerID]
+			if !ok {
+				continue
+			}
+			if s.TeamID == pbp.HomeTeam.ID {
+				onIce.Home = append(onIce.Home, spot)
+			} else {
+				onIce.Away = append(onIce.Away, spot)
+			}
+		}
+		plays = append(plays, onIce)
+	}
+	return plays
+}
+
+// clockSeconds converts a MM:SS game clock time to seconds
+func clockSeconds(clock string) (int, error) {
+	mins, sec, ok := strings.Cut(clock, ":")
+	m, err := strconv.Atoi(mins)
+	if !ok || err != nil || m < 0 {
+		return 0, fmt.Errorf("invalid time %q, want MM:SS", clock)
+	}
+	s, err := strconv.Atoi(sec)
+	if err != nil || s < 0 || s > 59 {
+		return 0, fmt.Errorf("invalid time %q, want MM:SS", clock)
+	}
+	return m*60 + s, nil
+}
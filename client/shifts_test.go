@@ -0,0 +1,95 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetGameShifts(t *testing.T) {
+	var url string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			url = req.URL.String()
+			return mockResponse(http.StatusOK, map[string]any{
+				"data": []Shift{
+					{PlayerID: 2, TeamID: 16, Period: 2, StartTime: "00:00", EndTime: "00:40", TypeCode: ShiftTypeShift},
+					{PlayerID: 1, TeamID: 3, Period: 1, StartTime: "00:45", EndTime: "01:30", TypeCode: ShiftTypeShift},
+					{PlayerID: 1, TeamID: 3, Period: 1, StartTime: "01:30", EndTime: "01:30", TypeCode: ShiftTypeGoal, EventNumber: 7},
+					{PlayerID: 2, TeamID: 16, Period: 1, StartTime: "00:00", EndTime: "00:45", TypeCode: ShiftTypeShift},
+					{PlayerID: 3, TeamID: 16, Period: 1, StartTime: "00:45", EndTime: "02:00", TypeCode: ShiftTypeShift},
+				},
+				"total": 5,
+			})
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	chart, err := client.GetGameShifts(context.Background(), 2024020750)
+	if err != nil {
+		t.Fatalf("GetGameShifts() error = %v", err)
+	}
+	if url != BaseURLStats+"/shiftcharts?cayenneExp=gameId%3D2024020750" {
+		t.Errorf("GetGameShifts() requested %s", url)
+	}
+	if first, last := chart.Shifts[0], chart.Shifts[len(chart.Shifts)-1]; first.PlayerID != 2 || first.Period != 1 || last.Period != 2 {
+		t.Errorf("Shifts not in period and start order: %+v", chart.Shifts)
+	}
+	if goals := chart.Goals(); len(goals) != 1 || goals[0].EventNumber != 7 {
+		t.Errorf("Goals() = %+v", goals)
+	}
+	if shifts := chart.Player(1); len(shifts) != 1 || shifts[0].Start() != 45 || shifts[0].End() != 90 {
+		t.Errorf("Player(1) = %+v", shifts)
+	}
+
+	tests := []struct {
+		period int
+		time   string
+		want   []int
+	}{
+		{period: 1, time: "00:00", want: []int{2}},
+		{period: 1, time: "00:45", want: []int{1, 3}},
+		{period: 1, time: "01:30", want: []int{3}},
+		{period: 2, time: "00:40", want: nil},
+	}
+	for _, tt := range tests {
+		shifts, err := chart.OnIce(tt.period, tt.time)
+		if err != nil {
+			t.Fatalf("OnIce(%d, %s) error = %v", tt.period, tt.time, err)
+		}
+		var ids []int
+		for _, s := range shifts {
+			ids = append(ids, s.PlayerID)
+		}
+		if !equalInts(ids, tt.want) {
+			t.Errorf("OnIce(%d, %s) = %v, want %v", tt.period, tt.time, ids, tt.want)
+		}
+	}
+	if _, err := chart.OnIce(1, "1:75"); err == nil {
+		t.Error("OnIce() with an invalid time returned no error")
+	}
+
+	pbp := &PlayByPlayResponse{
+		HomeTeam: Team{ID: 16},
+		AwayTeam: Team{ID: 3},
+		Plays: []PlayEvent{
+			{EventID: 6, PeriodDescriptor: PeriodDescriptor{Number: 1}, TimeInPeriod: "00:45", TypeDescKey: "faceoff"},
+			{EventID: 7, PeriodDescriptor: PeriodDescriptor{Number: 1}, TimeInPeriod: "01:30", TypeDescKey: "goal"},
+		},
+		RosterSpots: []RosterSpot{{PlayerID: 1, TeamID: 3}, {PlayerID: 2, TeamID: 16}, {PlayerID: 3, TeamID: 16}},
+	}
+	plays := chart.OnIceForPlays(pbp)
+	if len(plays) != 2 {
+		t.Fatalf("OnIceForPlays() returned %d plays, want 2", len(plays))
+	}
+	if faceoff := plays[0]; len(faceoff.Away) != 1 || len(faceoff.Home) != 1 || faceoff.Home[0].PlayerID != 3 {
+		t.Errorf("OnIceForPlays() faceoff = %+v, want players 1 and 3", faceoff)
+	}
+	if goal := plays[1]; len(goal.Away) != 1 || goal.Away[0].PlayerID != 1 || len(goal.Home) != 1 {
+		t.Errorf("OnIceForPlays() goal = %+v, want the scorer on the ice", goal)
+	}
+
+	if _, err := client.GetGameShifts(context.Background(), 0); err == nil {
+		t.Error("GetGameShifts() with an invalid game ID returned no error")
+	}
+}
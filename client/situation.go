@@ -0,0 +1,212 @@
+package nhl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SituationCode is who is on the ice, as four digits: 1 if the away goalie
+// is in net, the away team's skaters, the home team's skaters, then 1 if
+// the home goalie is in net. 1551 is five on five, 1451 a home power play
+// and 0651 the away team skating six with its goalie pulled.
+type SituationCode string
+
+// Situation is a decoded SituationCode
+type Situation struct {
+	AwayGoalieInNet bool
+	AwaySkaters     int
+	HomeSkaters     int
+	HomeGoalieInNet bool
+}
+
+// Decode returns the goalies and skaters on the ice
+func (c SituationCode) Decode() (Situation, error) {
+	if len(c) != 4 || (c[0] != '0' && c[0] != '1') || (c[3] != '0' && c[3] != '1') ||
+		c[1] < '0' || c[1] > '6' || c[2] < '0' || c[2] > '6' {
+		return Situation{}, fmt.Errorf("invalid situation code %q", string(c))
+	}
+	return Situation{
+		AwayGoalieInNet: c[0] == '1',
+		AwaySkaters:     int(c[1] - '0'),
+		HomeSkaters:     int(c[2] - '0'),
+		HomeGoalieInNet: c[3] == '1',
+	}, nil
+}
+
+// StrengthFor returns the strength of the home team, or of the away team
+// when home is false, or "" if the code does not decode
+func (c SituationCode) StrengthFor(home bool) Strength {
+	situation, err := c.Decode()
+	if err != nil {
+		return ""
+	}
+	return situation.StrengthFor(home)
+}
+
+// Skaters returns the skaters of the home team, or of the away team when
+// home is false, then those of the other team
+func (s Situation) Skaters(home bool) (own, other int) {
+	if home {
+		return s.HomeSkaters, s.AwaySkaters
+	}
+	return s.AwaySkaters, s.HomeSkaters
+}
+
+// GoaliesInNet reports whether the home team's goalie, or the away team's
+// when home is false, then the other team's goalie is in net
+func (s Situation) GoaliesInNet(home bool) (own, other bool) {
+	if home {
+		return s.HomeGoalieInNet, s.AwayGoalieInNet
+	}
+	return s.AwayGoalieInNet, s.HomeGoalieInNet
+}
+
+// StrengthFor returns the strength of the home team, or of the away team
+// when home is false. A pulled goalie decides the strength before the
+// skaters do, so a team with its goalie pulled on a power play is
+// StrengthExtraAttacker.
+func (s Situation) StrengthFor(home bool) Strength {
+	own, other := s.Skaters(home)
+	ownGoalie, otherGoalie := s.GoaliesInNet(home)
+	switch {
+	case own+other == 1:
+		return StrengthPenaltyShot
+	case !ownGoalie && otherGoalie:
+		return StrengthExtraAttacker
+	case ownGoalie && !otherGoalie:
+		return StrengthEmptyNet
+	case own > other:
+		return StrengthPowerPlay
+	case own < other:
+		return StrengthShortHanded
+	}
+	switch own {
+	case 5:
+		return StrengthEven
+	case 4:
+		return StrengthFourOnFour
+	case 3:
+		return StrengthThreeOnThree
+	}
+	return ""
+}
+
+// String returns the skaters on the ice, away team first, such as 5v4
+func (s Situation) String() string {
+	return fmt.Sprintf("%dv%d", s.AwaySkaters, s.HomeSkaters)
+}
+
+// Strength is a team's strength state relative to the other team
+type Strength string
+
+const (
+	StrengthEven          Strength = "EV"  // five on five
+	StrengthPowerPlay     Strength = "PP"  // more skaters than the other team
+	StrengthShortHanded   Strength = "SH"  // fewer skaters than the other team
+	StrengthFourOnFour    Strength = "4v4" // four on four
+	StrengthThreeOnThree  Strength = "3v3" // three on three, as in overtime
+	StrengthEmptyNet      Strength = "EN"  // the other team's goalie pulled
+	StrengthExtraAttacker Strength = "EA"  // own goalie pulled for an extra skater
+	StrengthPenaltyShot   Strength = "PS"  // one skater against a goalie, as in a shootout
+)
+
+// ParseStrength parses a strength code or name, such as PP, 5v5 or empty net
+func ParseStrength(s string) (Strength, error) {
+	switch code := strings.ToUpper(strings.TrimSpace(s)); code {
+	case "EV", "EVEN", "EVEN STRENGTH", "5V5":
+		return StrengthEven, nil
+	case "PP", "POWER PLAY", "POWERPLAY":
+		return StrengthPowerPlay, nil
+	case "SH", "SHORTHANDED", "SHORT HANDED":
+		return StrengthShortHanded, nil
+	case "4V4":
+		return StrengthFourOnFour, nil
+	case "3V3":
+		return StrengthThreeOnThree, nil
+	case "EN", "EMPTY NET":
+		return StrengthEmptyNet, nil
+	case "EA", "EXTRA ATTACKER", "6V5":
+		return StrengthExtraAttacker, nil
+	case "PS", "PENALTY SHOT", "SHOOTOUT":
+		return StrengthPenaltyShot, nil
+	}
+	return "", fmt.Errorf("invalid strength %q, want EV, PP, SH, 4v4, 3v3, EN, EA or PS", s)
+}
+
+// Known reports whether the strength is one of the strengths above
+func (s Strength) Known() bool {
+	switch s {
+	case StrengthEven, StrengthPowerPlay, StrengthShortHanded, StrengthFourOnFour,
+		StrengthThreeOnThree, StrengthEmptyNet, StrengthExtraAttacker, StrengthPenaltyShot:
+		return true
+	}
+	return false
+}
+
+// IsEven reports whether both teams have the same skaters and a goalie in net
+func (s Strength) IsEven() bool {
+	return s == StrengthEven || s == StrengthFourOnFour || s == StrengthThreeOnThree
+}
+
+// Name returns the strength's name, such as Power Play
+func (s Strength) Name() string {
+	switch s {
+	case StrengthEven:
+		return "Even Strength"
+	case StrengthPowerPlay:
+		return "Power Play"
+	case StrengthShortHanded:
+		return "Shorthanded"
+	case StrengthFourOnFour:
+		return "4 on 4"
+	case StrengthThreeOnThree:
+		return "3 on 3"
+	case StrengthEmptyNet:
+		return "Empty Net"
+	case StrengthExtraAttacker:
+		return "Extra Attacker"
+	case StrengthPenaltyShot:
+		return "Penalty Shot"
+	}
+	return string(s)
+}
+
+// Strength returns the strength of teamID, the home or away team, during
+// play, or "" for another team or a play without a situation code
+func (r *PlayByPlayResponse) Strength(play PlayEvent, teamID int) Strength {
+	switch teamID {
+	case r.HomeTeam.ID:
+		return play.SituationCode.StrengthFor(true)
+	case r.AwayTeam.ID:
+		return play.SituationCode.StrengthFor(false)
+	}
+	return ""
+}
+
+// PlaysAtStrength returns the plays during which teamID was at one of
+// strengths
+func (r *PlayByPlayResponse) PlaysAtStrength(teamID int, strengths ...Strength) []PlayEvent {
+	var plays []PlayEvent
+	for _, play := range r.Plays {
+		if slices.Contains(strengths, r.Strength(play, teamID)) {
+			plays = append(plays, play)
+		}
+	}
+	return plays
+}
+
+// StrengthCounts counts the plays of eventType, such as EventGoal, or of
+// every type when it is "", by teamID's strength
+func (r *PlayByPlayResponse) StrengthCounts(teamID int, eventType EventType) map[Strength]int {
+	counts := make(map[Strength]int)
+	for _, play := range r.Plays {
+		if eventType != "" && play.TypeDescKey != eventType {
+			continue
+		}
+		if strength := r.Strength(play, teamID); strength != "" {
+			counts[strength]++
+		}
+	}
+	return counts
+}
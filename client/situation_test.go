@@ -0,0 +1,80 @@
+package nhl
+
+import "testing"
+
+func TestSituationCode(t *testing.T) {
+	tests := []struct {
+		code       SituationCode
+		home, away Strength
+	}{
+		{"1551", StrengthEven, StrengthEven},
+		{"1451", StrengthPowerPlay, StrengthShortHanded},
+		{"1541", StrengthShortHanded, StrengthPowerPlay},
+		{"1441", StrengthFourOnFour, StrengthFourOnFour},
+		{"1331", StrengthThreeOnThree, StrengthThreeOnThree},
+		{"0651", StrengthEmptyNet, StrengthExtraAttacker},
+		{"1560", StrengthExtraAttacker, StrengthEmptyNet},
+		{"0641", StrengthEmptyNet, StrengthExtraAttacker}, // a pulled goalie on a power play
+		{"1010", StrengthPenaltyShot, StrengthPenaltyShot},
+		{"155", "", ""},
+		{"2551", "", ""},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if home, away := tt.code.StrengthFor(true), tt.code.StrengthFor(false); home != tt.home || away != tt.away {
+			t.Errorf("StrengthFor(%q) = %q home, %q away, want %q, %q", tt.code, home, away, tt.home, tt.away)
+		}
+	}
+
+	situation, err := SituationCode("0651").Decode()
+	want := Situation{AwayGoalieInNet: false, AwaySkaters: 6, HomeSkaters: 5, HomeGoalieInNet: true}
+	if err != nil || situation != want || situation.String() != "6v5" {
+		t.Errorf("Decode(0651) = %+v, %v, want %+v", situation, err, want)
+	}
+	if _, err := SituationCode("15x1").Decode(); err == nil {
+		t.Error("Decode(15x1) should fail")
+	}
+}
+
+func TestParseStrength(t *testing.T) {
+	for input, want := range map[string]Strength{"ev": StrengthEven, "5v5": StrengthEven, "Power Play": StrengthPowerPlay, " 6v5": StrengthExtraAttacker, "4V4": StrengthFourOnFour} {
+		if got, err := ParseStrength(input); err != nil || got != want {
+			t.Errorf("ParseStrength(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseStrength("5v3"); err == nil {
+		t.Error("ParseStrength(5v3) should fail")
+	}
+	if !StrengthFourOnFour.IsEven() || StrengthPowerPlay.IsEven() || StrengthShortHanded.Name() != "Shorthanded" {
+		t.Error("IsEven() or Name() is wrong")
+	}
+}
+
+func TestPlaysAtStrength(t *testing.T) {
+	pbp := &PlayByPlayResponse{
+		AwayTeam: Team{ID: 3},
+		HomeTeam: Team{ID: 16},
+		Plays: []PlayEvent{
+			{EventID: 1, SituationCode: "1551", TypeDescKey: "goal"},
+			{EventID: 2, SituationCode: "1451", TypeDescKey: "goal"},
+			{EventID: 3, SituationCode: "1451", TypeDescKey: "shot-on-goal"},
+			{EventID: 4, SituationCode: "1560", TypeDescKey: "goal"},
+		},
+	}
+
+	var ids []int
+	for _, play := range pbp.PlaysAtStrength(16, StrengthPowerPlay, StrengthExtraAttacker) {
+		ids = append(ids, play.EventID)
+	}
+	if !equalInts(ids, []int{2, 3, 4}) {
+		t.Errorf("PlaysAtStrength(home, PP, EA) = %v, want [2 3 4]", ids)
+	}
+	if plays := pbp.PlaysAtStrength(1, StrengthEven); len(plays) != 0 {
+		t.Errorf("PlaysAtStrength(other team) = %v, want none", plays)
+	}
+
+	counts := pbp.StrengthCounts(3, "goal")
+	if len(counts) != 3 || counts[StrengthEven] != 1 || counts[StrengthShortHanded] != 1 || counts[StrengthEmptyNet] != 1 {
+		t.Errorf("StrengthCounts(away, goal) = %v", counts)
+	}
+}
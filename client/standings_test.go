@@ -1,15 +1,17 @@
 package nhl_test
 
 import (
-	"go-nhl/internal/display"
+	"context"
 	"go-nhl/client"
+	"go-nhl/internal/display"
 	"testing"
 )
 
 func TestGetStandings(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
-	standings, err := client.GetStandings()
+	standings, err := client.GetStandings(ctx)
 	if err != nil {
 		t.Fatalf("GetStandings() error = %v", err)
 	}
@@ -74,6 +76,7 @@ func TestGetStandings(t *testing.T) {
 }
 
 func TestGetStandingsByDate(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	tests := []struct {
@@ -100,7 +103,7 @@ func TestGetStandingsByDate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			standings, err := client.GetStandingsByDate(tt.date)
+			standings, err := client.GetStandingsByDate(ctx, tt.date)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetStandingsByDate() error = %v, wantErr %v", err, tt.wantErr)
 				return
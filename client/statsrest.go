@@ -0,0 +1,289 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// StatsPageSize is the number of rows fetched per page when walking every
+// page of a stats REST report
+const StatsPageSize = 100
+
+// maxStatsPages stops a page walk if a misbehaving API never runs out
+const maxStatsPages = 1000
+
+// SkaterReport is a stats REST skater report
+type SkaterReport string
+
+const (
+	SkaterReportSummary            SkaterReport = "summary"
+	SkaterReportRealtime           SkaterReport = "realtime" // hits, blocks, giveaways and takeaways
+	SkaterReportFaceoffWins        SkaterReport = "faceoffwins"
+	SkaterReportFaceoffPercentages SkaterReport = "faceoffpercentages"
+	SkaterReportPowerPlay          SkaterReport = "powerplay"
+	SkaterReportPenaltyKill        SkaterReport = "penaltykill"
+	SkaterReportTimeOnIce          SkaterReport = "timeonice"
+)
+
+// GoalieReport is a stats REST goalie report
+type GoalieReport string
+
+const (
+	GoalieReportSummary           GoalieReport = "summary"
+	GoalieReportAdvanced          GoalieReport = "advanced"
+	GoalieReportSavesByStrength   GoalieReport = "savesByStrength"
+	GoalieReportStartedVsRelieved GoalieReport = "startedVsRelieved"
+)
+
+// StatsQuery selects, sorts and pages the rows of a stats REST report
+type StatsQuery struct {
+	Cayenne   Cayenne     // filters the underlying game or season rows, such as by season
+	Fact      Cayenne     // filters the aggregated rows, such as gamesPlayed>=10
+	Sort      []StatsSort // sorted by the first property, then the next
+	Start     int         // index of the first row
+	Limit     int         // rows per page, 0 for the API's default
+	Aggregate bool        // combine a player's seasons into one row
+	ByGame    bool        // one row per game rather than per season
+}
+
+// values encodes the query as stats REST parameters
+func (q *StatsQuery) values() url.Values {
+	v := url.Values{}
+	if q == nil {
+		return v
+	}
+	if !q.Cayenne.IsZero() {
+		v.Set("cayenneExp", q.Cayenne.String())
+	}
+	if !q.Fact.IsZero() {
+		v.Set("factCayenneExp", q.Fact.String())
+	}
+	if len(q.Sort) > 0 {
+		v.Set("sort", encodeSorts(q.Sort))
+	}
+	if q.Start > 0 {
+		v.Set("start", strconv.Itoa(q.Start))
+	}
+	if q.Limit != 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Aggregate {
+		v.Set("isAggregate", "true")
+	}
+	if q.ByGame {
+		v.Set("isGame", "true")
+	}
+	return v
+}
+
+// StatsPage is one page of a stats REST report. Rows differ by report, so
+// they are kept raw: decode them with Decode, or read them as StatsRows.
+type StatsPage struct {
+	Data  []json.RawMessage `json:"data"`
+	Total int               `json:"total"` // rows matching the query across every page
+}
+
+// Decode unmarshals the page's rows into v, a pointer to a slice such as
+// *[]SkaterSummaryRow
+func (p *StatsPage) Decode(v any) error {
+	b, err := json.Marshal(p.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Rows returns the page's rows as maps of column to value
+func (p *StatsPage) Rows() ([]StatsRow, error) {
+	rows := []StatsRow{}
+	if err := p.Decode(&rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// StatsRow is a stats REST row of any report
+type StatsRow map[string]any
+
+// Float returns a numeric column, or 0 when it is missing or null
+func (r StatsRow) Float(column string) float64 {
+	f, _ := r[column].(float64)
+	return f
+}
+
+// Int returns a numeric column as an int, or 0 when it is missing or null
+func (r StatsRow) Int(column string) int {
+	return int(r.Float(column))
+}
+
+// String returns a text column, or "" when it is missing or null
+func (r StatsRow) String(column string) string {
+	s, _ := r[column].(string)
+	return s
+}
+
+// SkaterSummaryRow is a row of the skater summary report
+type SkaterSummaryRow struct {
+	PlayerID         int      `json:"playerId"`
+	SkaterFullName   string   `json:"skaterFullName"`
+	LastName         string   `json:"lastName"`
+	TeamAbbrevs      string   `json:"teamAbbrevs"` // comma separated when traded
+	PositionCode     Position `json:"positionCode"`
+	ShootsCatches    string   `json:"shootsCatches"`
+	SeasonID         int      `json:"seasonId"`
+	GamesPlayed      int      `json:"gamesPlayed"`
+	Goals            int      `json:"goals"`
+	Assists          int      `json:"assists"`
+	Points           int      `json:"points"`
+	PlusMinus        int      `json:"plusMinus"`
+	PenaltyMinutes   int      `json:"penaltyMinutes"`
+	PointsPerGame    float64  `json:"pointsPerGame"`
+	EvGoals          int      `json:"evGoals"`
+	EvPoints         int      `json:"evPoints"`
+	PPGoals          int      `json:"ppGoals"`
+	PPPoints         int      `json:"ppPoints"`
+	SHGoals          int      `json:"shGoals"`
+	SHPoints         int      `json:"shPoints"`
+	GameWinningGoals int      `json:"gameWinningGoals"`
+	OTGoals          int      `json:"otGoals"`
+	Shots            int      `json:"shots"`
+	ShootingPct      float64  `json:"shootingPct"`
+	TimeOnIcePerGame float64  `json:"timeOnIcePerGame"` // seconds
+	FaceoffWinPct    float64  `json:"faceoffWinPct"`
+}
+
+// GoalieSummaryRow is a row of the goalie summary report
+type GoalieSummaryRow struct {
+	PlayerID            int     `json:"playerId"`
+	GoalieFullName      string  `json:"goalieFullName"`
+	LastName            string  `json:"lastName"`
+	TeamAbbrevs         string  `json:"teamAbbrevs"` // comma separated when traded
+	ShootsCatches       string  `json:"shootsCatches"`
+	SeasonID            int     `json:"seasonId"`
+	GamesPlayed         int     `json:"gamesPlayed"`
+	GamesStarted        int     `json:"gamesStarted"`
+	Wins                int     `json:"wins"`
+	Losses              int     `json:"losses"`
+	OTLosses            int     `json:"otLosses"`
+	ShotsAgainst        int     `json:"shotsAgainst"`
+	Saves               int     `json:"saves"`
+	GoalsAgainst        int     `json:"goalsAgainst"`
+	SavePct             float64 `json:"savePct"`
+	GoalsAgainstAverage float64 `json:"goalsAgainstAverage"`
+	Shutouts            int     `json:"shutouts"`
+	TimeOnIce           int     `json:"timeOnIce"` // seconds
+}
+
+// StatsClient reads reports from the NHL stats REST API
+// (api.nhle.com/stats/rest), sharing its Client's transport, cache and
+// retries
+type StatsClient struct {
+	client *Client
+}
+
+// NewStatsClient creates a stats REST client configured by opts
+func NewStatsClient(opts ...Option) *StatsClient {
+	return NewClient(opts...).Stats()
+}
+
+// Stats returns a stats REST client sharing c's configuration
+func (c *Client) Stats() *StatsClient {
+	return &StatsClient{client: c}
+}
+
+// Skaters returns one page of a skater report
+func (s *StatsClient) Skaters(ctx context.Context, report SkaterReport, query *StatsQuery) (*StatsPage, error) {
+	return s.page(ctx, "skater", string(report), query)
+}
+
+// AllSkaters returns every page of a skater report from query.Start on,
+// walking the pages query.Limit rows at a time, or StatsPageSize
+func (s *StatsClient) AllSkaters(ctx context.Context, report SkaterReport, query *StatsQuery) (*StatsPage, error) {
+	return s.walk(ctx, "skater", string(report), query)
+}
+
+// Goalies returns one page of a goalie report
+func (s *StatsClient) Goalies(ctx context.Context, report GoalieReport, query *StatsQuery) (*StatsPage, error) {
+	return s.page(ctx, "goalie", string(report), query)
+}
+
+// AllGoalies returns every page of a goalie report from query.Start on,
+// walking the pages query.Limit rows at a time, or StatsPageSize
+func (s *StatsClient) AllGoalies(ctx context.Context, report GoalieReport, query *StatsQuery) (*StatsPage, error) {
+	return s.walk(ctx, "goalie", string(report), query)
+}
+
+// SkaterSummary returns every row of the skater summary report
+func (s *StatsClient) SkaterSummary(ctx context.Context, query *StatsQuery) ([]SkaterSummaryRow, error) {
+	page, err := s.AllSkaters(ctx, SkaterReportSummary, query)
+	if err != nil {
+		return nil, err
+	}
+	rows := []SkaterSummaryRow{}
+	if err := page.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode skater summary: %w", err)
+	}
+	return rows, nil
+}
+
+// GoalieSummary returns every row of the goalie summary report
+func (s *StatsClient) GoalieSummary(ctx context.Context, query *StatsQuery) ([]GoalieSummaryRow, error) {
+	page, err := s.AllGoalies(ctx, GoalieReportSummary, query)
+	if err != nil {
+		return nil, err
+	}
+	rows := []GoalieSummaryRow{}
+	if err := page.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode goalie summary: %w", err)
+	}
+	return rows, nil
+}
+
+// page fetches one page of the kind/report report
+func (s *StatsClient) page(ctx context.Context, kind, report string, query *StatsQuery) (*StatsPage, error) {
+	if report == "" {
+		return nil, fmt.Errorf("%s report cannot be empty", kind)
+	}
+
+	u := fmt.Sprintf("%s/%s/%s", s.client.statsBaseURL, kind, url.PathEscape(report))
+	if params := query.values().Encode(); params != "" {
+		u += "?" + params
+	}
+	var response StatsPage
+	if err := s.client.get(ctx, u, &response); err != nil {
+		return nil, fmt.Errorf("failed to get %s %s report: %w", kind, report, err)
+	}
+	if response.Data == nil {
+		response.Data = []json.RawMessage{}
+	}
+	return &response, nil
+}
+
+// walk fetches every page of the kind/report report from query.Start on
+func (s *StatsClient) walk(ctx context.Context, kind, report string, query *StatsQuery) (*StatsPage, error) {
+	q := StatsQuery{}
+	if query != nil {
+		q = *query
+	}
+	if q.Limit <= 0 {
+		q.Limit = StatsPageSize
+	}
+
+	all := &StatsPage{Data: []json.RawMessage{}}
+	for range maxStatsPages {
+		page, err := s.page(ctx, kind, report, &q)
+		if err != nil {
+			return nil, err
+		}
+		all.Data = append(all.Data, page.Data...)
+		all.Total = page.Total
+		q.Start += len(page.Data)
+		if len(page.Data) == 0 || q.Start >= page.Total {
+			return all, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %s report has more than %d pages", kind, report, maxStatsPages)
+}
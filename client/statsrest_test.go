@@ -0,0 +1,99 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestStatsClientQuery(t *testing.T) {
+	var query map[string]string
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/stats/rest/en/skater/realtime" {
+				t.Errorf("requested %s", req.URL.Path)
+			}
+			query = map[string]string{}
+			for key := range req.URL.Query() {
+				query[key] = req.URL.Query().Get(key)
+			}
+			return mockResponse(http.StatusOK, map[string]any{"data": []map[string]any{{"playerId": 8480036, "hits": 42.0}}, "total": 1})
+		},
+	}
+	stats := NewStatsClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry))
+
+	page, err := stats.Skaters(context.Background(), SkaterReportRealtime, &StatsQuery{
+		Cayenne:   And(SeasonIs(20232024), GameTypeIs(GameTypeRegularSeason)),
+		Fact:      Ge("gamesPlayed", 10),
+		Sort:      []StatsSort{Desc("hits"), Asc("playerId")},
+		Start:     50,
+		Limit:     25,
+		Aggregate: true,
+	})
+	if err != nil {
+		t.Fatalf("Skaters() error = %v", err)
+	}
+	want := map[string]string{
+		"cayenneExp":     "seasonId=20232024 and gameTypeId=2",
+		"factCayenneExp": "gamesPlayed>=10",
+		"sort":           `[{"property":"hits","direction":"DESC"},{"property":"playerId","direction":"ASC"}]`,
+		"start":          "50",
+		"limit":          "25",
+		"isAggregate":    "true",
+	}
+	for key, value := range want {
+		if query[key] != value {
+			t.Errorf("query %s = %q, want %q", key, query[key], value)
+		}
+	}
+	if len(query) != len(want) {
+		t.Errorf("query = %v, want only %v", query, want)
+	}
+
+	rows, err := page.Rows()
+	if err != nil || len(rows) != 1 || rows[0].Int("playerId") != 8480036 || rows[0].Float("hits") != 42 || rows[0].String("missing") != "" {
+		t.Errorf("Rows() = %v, %v", rows, err)
+	}
+}
+
+func TestStatsClientWalksPages(t *testing.T) {
+	const total = 7
+	var starts []int
+	httpClient := &mockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			starts = append(starts, start)
+			var data []GoalieSummaryRow
+			for id := start; id < min(start+limit, total); id++ {
+				data = append(data, GoalieSummaryRow{PlayerID: id})
+			}
+			return mockResponse(http.StatusOK, map[string]any{"data": data, "total": total})
+		},
+	}
+	stats := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(NoRetry)).Stats()
+
+	rows, err := stats.GoalieSummary(context.Background(), &StatsQuery{Start: 1, Limit: 3})
+	if err != nil {
+		t.Fatalf("GoalieSummary() error = %v", err)
+	}
+	if !equalInts(starts, []int{1, 4}) {
+		t.Errorf("requested pages starting at %v, want [1 4]", starts)
+	}
+	var ids []int
+	for _, row := range rows {
+		ids = append(ids, row.PlayerID)
+	}
+	if !equalInts(ids, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("GoalieSummary() = %v, want players 1 to 6", ids)
+	}
+
+	starts = nil
+	if _, err := stats.AllGoalies(context.Background(), GoalieReportSummary, nil); err != nil {
+		t.Fatalf("AllGoalies() error = %v", err)
+	}
+	if !equalInts(starts, []int{0}) {
+		t.Errorf("AllGoalies() requested pages starting at %v, want one page", starts)
+	}
+}
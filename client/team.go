@@ -1,77 +1,321 @@
 package nhl
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
-// GetTeams returns a list of all NHL teams
-func (c *Client) GetTeams() (*TeamsResponse, error) {
-	// Check cache first
+// DefaultTeamsTTL is how long the team directory is kept before it is
+// rebuilt, so relocations and expansion teams appear without a release
+const DefaultTeamsTTL = 24 * time.Hour
+
+// fallbackTeamsTTL is how long the static team table is used after the
+// live directory fails to load, before trying again
+const fallbackTeamsTTL = 5 * time.Minute
+
+// WithTeamsTTL sets how long the team directory is cached. A zero or
+// negative TTL keeps it for the life of the client.
+func WithTeamsTTL(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.teamsTTL = ttl
+	}
+}
+
+// teamDirectory is the cached team directory
+type teamDirectory struct {
+	active  *TeamsResponse // Current teams, as returned by GetTeams
+	all     []TeamInfo     // Current teams followed by inactive franchises
+	expires time.Time
+}
+
+// GetTeams returns the current NHL teams. The directory is built from the
+// standings and the stats API's team and franchise lists, falling back to
+// a built-in table when those are unavailable.
+func (c *Client) GetTeams(ctx context.Context) (*TeamsResponse, error) {
+	dir, err := c.teamDirectory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return dir.active, nil
+}
+
+// GetTeamByIdentifier returns a team by its identifier (abbreviation, name, or ID).
+// Current teams are preferred, but inactive franchises such as ARI are found too.
+func (c *Client) GetTeamByIdentifier(ctx context.Context, identifier string) (*TeamInfo, error) {
+	dir, err := c.teamDirectory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try to parse as team ID first
+	if id, err := strconv.Atoi(identifier); err == nil {
+		for _, team := range dir.all {
+			if team.ID == id {
+				return &team, nil
+			}
+		}
+	}
+
+	// Try to match by abbreviation or name
+	identifier = strings.ToLower(identifier)
+	for _, team := range dir.all {
+		if strings.ToLower(team.Abbreviation) == identifier ||
+			strings.ToLower(team.Name.Default) == identifier {
+			return &team, nil
+		}
+	}
+
+	return nil, fmt.Errorf("team %s: %w", identifier, ErrNotFound)
+}
+
+// directoryLoad is a team directory load in progress, closing done when
+// it finishes
+type directoryLoad struct {
+	done chan struct{}
+}
+
+// teamDirectory returns the cached team directory, loading it when missing
+// or expired. One caller loads it outside the lock while the others wait
+// for that load or give up when their own ctx ends.
+func (c *Client) teamDirectory(ctx context.Context) (*teamDirectory, error) {
 	c.cacheMutex.RLock()
-	if c.teams != nil {
-		defer c.cacheMutex.RUnlock()
-		return c.teams, nil
+	if dir := c.teams; dir != nil && !expired(dir.expires) {
+		c.cacheMutex.RUnlock()
+		return dir, nil
 	}
 	c.cacheMutex.RUnlock()
 
-	// Cache miss, fetch teams
-	c.cacheMutex.Lock()
-	defer c.cacheMutex.Unlock()
+	for {
+		c.cacheMutex.Lock()
+		if dir := c.teams; dir != nil && !expired(dir.expires) {
+			c.cacheMutex.Unlock()
+			return dir, nil
+		}
+		load := c.teamsLoad
+		if load == nil {
+			load = &directoryLoad{done: make(chan struct{})}
+			c.teamsLoad = load
+			c.cacheMutex.Unlock()
+			return c.loadTeamDirectory(ctx, load)
+		}
+		c.cacheMutex.Unlock()
 
-	// Double-check cache in case another goroutine populated it
-	if c.teams != nil {
-		return c.teams, nil
+		// Check again once the load finishes, loading the directory here
+		// if the loading caller gave up
+		select {
+		case <-load.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 	}
+}
 
+// loadTeamDirectory loads the team directory for load and caches it,
+// falling back to the static team table when the live data is unavailable
+func (c *Client) loadTeamDirectory(ctx context.Context, load *directoryLoad) (*teamDirectory, error) {
+	var dir *teamDirectory
+	defer func() {
+		// A purge during the load drops it, so the purged directory is
+		// not stored again
+		c.cacheMutex.Lock()
+		if c.teamsLoad == load {
+			if dir != nil {
+				c.teams = dir
+			}
+			c.teamsLoad = nil
+		}
+		c.cacheMutex.Unlock()
+		close(load.done)
+	}()
+
+	ttl := c.teamsTTL
+	teams, err := c.loadTeams(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		teams = staticTeams()
+		if ttl <= 0 || ttl > fallbackTeamsTTL {
+			ttl = fallbackTeamsTTL
+		}
+	}
+
+	dir = &teamDirectory{all: teams, active: &TeamsResponse{Teams: []TeamInfo{}}}
+	for _, team := range teams {
+		if team.Active {
+			dir.active.Teams = append(dir.active.Teams, team)
+		}
+	}
+	if ttl > 0 {
+		dir.expires = time.Now().Add(ttl)
+	}
+	return dir, nil
+}
+
+// statsTeam is a team from the stats API's team list
+type statsTeam struct {
+	ID          int    `json:"id"`
+	FranchiseID int    `json:"franchiseId"`
+	FullName    string `json:"fullName"`
+	TriCode     string `json:"triCode"`
+}
+
+// franchise is an entry in the stats API's franchise list
+type franchise struct {
+	ID               int    `json:"id"`
+	FullName         string `json:"fullName"`
+	LastSeasonID     *int   `json:"lastSeasonId"`
+	MostRecentTeamID int    `json:"mostRecentTeamId"`
+	TeamPlaceName    string `json:"teamPlaceName"`
+}
+
+// loadTeams builds the team directory from live data. The standings give
+// the current teams with their conference, division and logo; the stats
+// API adds team and franchise IDs and the franchises that no longer play.
+func (c *Client) loadTeams(ctx context.Context) ([]TeamInfo, error) {
+	standings, err := c.GetStandings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(standings.Standings) == 0 {
+		return nil, fmt.Errorf("standings have no teams")
+	}
+
+	var teamList struct {
+		Data []statsTeam `json:"data"`
+	}
+	if err := c.get(ctx, c.statsBaseURL+"/team", &teamList); err != nil {
+		return nil, fmt.Errorf("failed to get team list: %w", err)
+	}
+
+	// The franchise list only adds inactive franchises, so carry on without it
+	var franchiseList struct {
+		Data []franchise `json:"data"`
+	}
+	if err := c.get(ctx, c.statsBaseURL+"/franchise", &franchiseList); err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// Tricodes are reused over time, so prefer each franchise's most recent team
+	mostRecent := make(map[int]bool)
+	for _, f := range franchiseList.Data {
+		mostRecent[f.MostRecentTeamID] = true
+	}
+	byTriCode := make(map[string]statsTeam)
+	byID := make(map[int]statsTeam)
+	for _, team := range teamList.Data {
+		byID[team.ID] = team
+		if current, ok := byTriCode[team.TriCode]; !ok || mostRecent[team.ID] || (!mostRecent[current.ID] && team.ID > current.ID) {
+			byTriCode[team.TriCode] = team
+		}
+	}
+
+	var teams []TeamInfo
+	activeIDs := make(map[int]bool)
+	for _, st := range standings.Standings {
+		abbrev := st.TeamAbbrev.Default
+		team, ok := byTriCode[abbrev]
+		if !ok {
+			return nil, fmt.Errorf("team %s missing from the team list", abbrev)
+		}
+		activeIDs[team.ID] = true
+		teams = append(teams, TeamInfo{
+			ID:           team.ID,
+			Name:         st.TeamName,
+			Abbreviation: abbrev,
+			City:         st.PlaceName,
+			TriCode:      abbrev,
+			FranchiseID:  team.FranchiseID,
+			Active:       true,
+			Conference:   st.Conference,
+			Division:     st.Division,
+			Logo:         st.TeamLogo,
+		})
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+
+	var inactive []TeamInfo
+	for _, f := range franchiseList.Data {
+		team, ok := byID[f.MostRecentTeamID]
+		if f.LastSeasonID == nil || !ok || activeIDs[team.ID] {
+			continue
+		}
+		inactive = append(inactive, TeamInfo{
+			ID:           team.ID,
+			Name:         LanguageNames{Default: team.FullName},
+			Abbreviation: team.TriCode,
+			City:         LanguageNames{Default: f.TeamPlaceName},
+			TriCode:      team.TriCode,
+			FranchiseID:  f.ID,
+			Logo:         teamLogo(team.TriCode),
+		})
+	}
+	sort.Slice(inactive, func(i, j int) bool { return inactive[i].ID < inactive[j].ID })
+
+	return append(teams, inactive...), nil
+}
+
+// teamLogo returns the light logo URL for a team abbreviation
+func teamLogo(abbrev string) string {
+	return "https://assets.nhle.com/logos/nhl/svg/" + abbrev + "_light.svg"
+}
+
+// staticTeams returns the built-in team table used when the live directory
+// cannot be loaded
+func staticTeams() []TeamInfo {
 	// Known NHL teams with their IDs, abbreviations, and full names
 	teamData := []struct {
-		ID   int
-		Abbr string
-		Name string
-		City string
+		ID         int
+		Abbr       string
+		Name       string
+		City       string
+		Conference string
+		Division   string
+		Active     bool
 	}{
-		{1, "NJD", "New Jersey Devils", "New Jersey"},
-		{2, "NYI", "New York Islanders", "New York"},
-		{3, "NYR", "New York Rangers", "New York"},
-		{4, "PHI", "Philadelphia Flyers", "Philadelphia"},
-		{5, "PIT", "Pittsburgh Penguins", "Pittsburgh"},
-		{6, "BOS", "Boston Bruins", "Boston"},
-		{7, "BUF", "Buffalo Sabres", "Buffalo"},
-		{8, "MTL", "Montreal Canadiens", "Montreal"},
-		{9, "OTT", "Ottawa Senators", "Ottawa"},
-		{10, "TOR", "Toronto Maple Leafs", "Toronto"},
-		{12, "CAR", "Carolina Hurricanes", "Carolina"},
-		{13, "FLA", "Florida Panthers", "Florida"},
-		{14, "TBL", "Tampa Bay Lightning", "Tampa Bay"},
-		{15, "WSH", "Washington Capitals", "Washington"},
-		{16, "CHI", "Chicago Blackhawks", "Chicago"},
-		{17, "DET", "Detroit Red Wings", "Detroit"},
-		{18, "NSH", "Nashville Predators", "Nashville"},
-		{19, "STL", "St. Louis Blues", "St. Louis"},
-		{20, "CGY", "Calgary Flames", "Calgary"},
-		{21, "COL", "Colorado Avalanche", "Colorado"},
-		{22, "EDM", "Edmonton Oilers", "Edmonton"},
-		{23, "VAN", "Vancouver Canucks", "Vancouver"},
-		{24, "ANA", "Anaheim Ducks", "Anaheim"},
-		{25, "DAL", "Dallas Stars", "Dallas"},
-		{26, "LAK", "Los Angeles Kings", "Los Angeles"},
-		{28, "SJS", "San Jose Sharks", "San Jose"},
-		{29, "CBJ", "Columbus Blue Jackets", "Columbus"},
-		{30, "MIN", "Minnesota Wild", "Minnesota"},
-		{52, "WPG", "Winnipeg Jets", "Winnipeg"},
-		{53, "ARI", "Arizona Coyotes", "Arizona"},
-		{54, "VGK", "Vegas Golden Knights", "Vegas"},
-		{55, "SEA", "Seattle Kraken", "Seattle"},
-	}
-
-	teams := &TeamsResponse{
-		Teams: []TeamInfo{},
+		{1, "NJD", "New Jersey Devils", "New Jersey", "Eastern", "Metropolitan", true},
+		{2, "NYI", "New York Islanders", "New York", "Eastern", "Metropolitan", true},
+		{3, "NYR", "New York Rangers", "New York", "Eastern", "Metropolitan", true},
+		{4, "PHI", "Philadelphia Flyers", "Philadelphia", "Eastern", "Metropolitan", true},
+		{5, "PIT", "Pittsburgh Penguins", "Pittsburgh", "Eastern", "Metropolitan", true},
+		{6, "BOS", "Boston Bruins", "Boston", "Eastern", "Atlantic", true},
+		{7, "BUF", "Buffalo Sabres", "Buffalo", "Eastern", "Atlantic", true},
+		{8, "MTL", "Montreal Canadiens", "Montreal", "Eastern", "Atlantic", true},
+		{9, "OTT", "Ottawa Senators", "Ottawa", "Eastern", "Atlantic", true},
+		{10, "TOR", "Toronto Maple Leafs", "Toronto", "Eastern", "Atlantic", true},
+		{12, "CAR", "Carolina Hurricanes", "Carolina", "Eastern", "Metropolitan", true},
+		{13, "FLA", "Florida Panthers", "Florida", "Eastern", "Atlantic", true},
+		{14, "TBL", "Tampa Bay Lightning", "Tampa Bay", "Eastern", "Atlantic", true},
+		{15, "WSH", "Washington Capitals", "Washington", "Eastern", "Metropolitan", true},
+		{16, "CHI", "Chicago Blackhawks", "Chicago", "Western", "Central", true},
+		{17, "DET", "Detroit Red Wings", "Detroit", "Eastern", "Atlantic", true},
+		{18, "NSH", "Nashville Predators", "Nashville", "Western", "Central", true},
+		{19, "STL", "St. Louis Blues", "St. Louis", "Western", "Central", true},
+		{20, "CGY", "Calgary Flames", "Calgary", "Western", "Pacific", true},
+		{21, "COL", "Colorado Avalanche", "Colorado", "Western", "Central", true},
+		{22, "EDM", "Edmonton Oilers", "Edmonton", "Western", "Pacific", true},
+		{23, "VAN", "Vancouver Canucks", "Vancouver", "Western", "Pacific", true},
+		{24, "ANA", "Anaheim Ducks", "Anaheim", "Western", "Pacific", true},
+		{25, "DAL", "Dallas Stars", "Dallas", "Western", "Central", true},
+		{26, "LAK", "Los Angeles Kings", "Los Angeles", "Western", "Pacific", true},
+		{28, "SJS", "San Jose Sharks", "San Jose", "Western", "Pacific", true},
+		{29, "CBJ", "Columbus Blue Jackets", "Columbus", "Eastern", "Metropolitan", true},
+		{30, "MIN", "Minnesota Wild", "Minnesota", "Western", "Central", true},
+		{52, "WPG", "Winnipeg Jets", "Winnipeg", "Western", "Central", true},
+		{54, "VGK", "Vegas Golden Knights", "Vegas", "Western", "Pacific", true},
+		{55, "SEA", "Seattle Kraken", "Seattle", "Western", "Pacific", true},
+		{68, "UTA", "Utah Mammoth", "Utah", "Western", "Central", true},
+		{53, "ARI", "Arizona Coyotes", "Arizona", "Western", "Central", false},
 	}
 
+	teams := make([]TeamInfo, 0, len(teamData))
 	for _, td := range teamData {
-		teams.Teams = append(teams.Teams, TeamInfo{
+		teams = append(teams, TeamInfo{
 			ID:           td.ID,
 			Abbreviation: td.Abbr,
 			TriCode:      td.Abbr,
@@ -81,55 +325,87 @@ func (c *Client) GetTeams() (*TeamsResponse, error) {
 			City: LanguageNames{
 				Default: td.City,
 			},
-			Active: true,
+			Active:     td.Active,
+			Conference: td.Conference,
+			Division:   td.Division,
+			Logo:       teamLogo(td.Abbr),
 		})
 	}
+	return teams
+}
 
-	c.teams = teams
-	return teams, nil
+// GetTeamRoster returns the current roster for a team
+func (c *Client) GetTeamRoster(ctx context.Context, identifier string) (*RosterResponse, error) {
+	return c.GetTeamRosterForSeason(ctx, identifier, 0)
 }
 
-// GetTeamByIdentifier returns a team by its identifier (abbreviation, name, or ID)
-func (c *Client) GetTeamByIdentifier(identifier string) (*TeamInfo, error) {
-	teams, err := c.GetTeams()
+// GetTeamRosterForSeason returns a team's roster for a season, such as
+// 20102011. If seasonID is 0 the current roster is returned.
+func (c *Client) GetTeamRosterForSeason(ctx context.Context, identifier string, seasonID int) (*RosterResponse, error) {
+	if seasonID < 0 {
+		return nil, fmt.Errorf("invalid season ID: %d", seasonID)
+	}
+
+	tricode, err := c.rosterTricode(ctx, identifier)
 	if err != nil {
 		return nil, err
 	}
 
-	// Try to parse as team ID first
-	if id, err := strconv.Atoi(identifier); err == nil {
-		for _, team := range teams.Teams {
-			if team.ID == id {
-				return &team, nil
-			}
-		}
+	season := "current"
+	if seasonID > 0 {
+		season = fmt.Sprint(seasonID)
 	}
 
-	// Try to match by abbreviation or name
-	identifier = strings.ToLower(identifier)
-	for _, team := range teams.Teams {
-		if strings.ToLower(team.Abbreviation) == identifier ||
-			strings.ToLower(team.Name.Default) == identifier {
-			return &team, nil
-		}
+	url := fmt.Sprintf("%s/roster/%s/%s", c.baseURL, tricode, season)
+	var response RosterResponse
+	err = c.get(ctx, url, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster: %w", err)
 	}
 
-	return nil, fmt.Errorf("team not found: %s", identifier)
+	return &response, nil
 }
 
-// GetTeamRoster returns the current roster for a team
-func (c *Client) GetTeamRoster(identifier string) (*RosterResponse, error) {
-	team, err := c.GetTeamByIdentifier(identifier)
+// GetRosterSeasons returns the seasons a team has rosters for, oldest first
+func (c *Client) GetRosterSeasons(ctx context.Context, identifier string) ([]int, error) {
+	tricode, err := c.rosterTricode(ctx, identifier)
 	if err != nil {
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/roster/%s/current", c.baseURL, team.Abbreviation)
-	var response RosterResponse
-	err = c.get(url, &response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get roster: %v", err)
+	url := fmt.Sprintf("%s/roster-season/%s", c.baseURL, tricode)
+	var seasons []int
+	if err := c.get(ctx, url, &seasons); err != nil {
+		return nil, fmt.Errorf("failed to get roster seasons: %w", err)
 	}
+	sort.Ints(seasons)
+	return seasons, nil
+}
 
-	return &response, nil
+// rosterTricode returns the tricode to request rosters by. Relocated
+// franchises, such as ATL or QUE, are not in the team directory but still
+// have rosters under their old tricode, so a three letter identifier the
+// directory does not know is passed through as is.
+func (c *Client) rosterTricode(ctx context.Context, identifier string) (string, error) {
+	team, err := c.GetTeamByIdentifier(ctx, identifier)
+	if err == nil {
+		return team.Abbreviation, nil
+	}
+	if !errors.Is(err, ErrNotFound) || !isTricode(identifier) {
+		return "", err
+	}
+	return strings.ToUpper(identifier), nil
+}
+
+// isTricode reports whether s is three letters, such as NYR
+func isTricode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+			return false
+		}
+	}
+	return true
 }
@@ -1,13 +1,23 @@
 package nhl_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"go-nhl/client"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestGetTeams(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
-	teams, err := client.GetTeams()
+	teams, err := client.GetTeams(ctx)
 	if err != nil {
 		t.Fatalf("GetTeams() error = %v", err)
 	}
@@ -19,7 +29,7 @@ func TestGetTeams(t *testing.T) {
 	}
 
 	// Test caching
-	teams2, err := client.GetTeams()
+	teams2, err := client.GetTeams(ctx)
 	if err != nil {
 		t.Fatalf("GetTeams() second call error = %v", err)
 	}
@@ -29,6 +39,7 @@ func TestGetTeams(t *testing.T) {
 }
 
 func TestGetTeamByIdentifier(t *testing.T) {
+	ctx := context.Background()
 	client := nhl.NewClient()
 
 	tests := []struct {
@@ -65,7 +76,7 @@ func TestGetTeamByIdentifier(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			team, err := client.GetTeamByIdentifier(tt.identifier)
+			team, err := client.GetTeamByIdentifier(ctx, tt.identifier)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetTeamByIdentifier() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -76,3 +87,168 @@ func TestGetTeamByIdentifier(t *testing.T) {
 		})
 	}
 }
+
+// rosterTransport answers the team directory with the Rangers alone and
+// rosters for any team, recording the roster paths requested
+type rosterTransport struct {
+	paths []string
+}
+
+func (r *rosterTransport) Do(req *http.Request) (*http.Response, error) {
+	var body any = map[string]any{"data": []any{}}
+	switch path := req.URL.Path; {
+	case strings.HasSuffix(path, "/standings/now"):
+		body = map[string]any{"standings": []any{map[string]any{
+			"teamAbbrev": map[string]any{"default": "NYR"},
+			"teamName":   map[string]any{"default": "New York Rangers"},
+		}}}
+	case strings.Contains(path, "/roster-season/"):
+		r.paths = append(r.paths, path)
+		body = []int{19992000, 20102011}
+	case strings.Contains(path, "/roster/"):
+		r.paths = append(r.paths, path)
+		body = map[string]any{"forwards": []any{}, "defensemen": []any{}, "goalies": []any{}}
+	}
+	data, _ := json.Marshal(body)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func TestRosterForRelocatedFranchise(t *testing.T) {
+	ctx := context.Background()
+	transport := &rosterTransport{}
+	client := nhl.NewClient(nhl.WithHTTPClient(transport), nhl.WithRetryPolicy(nhl.NoRetry))
+
+	if _, err := client.GetTeamRosterForSeason(ctx, "atl", 19992000); err != nil {
+		t.Fatalf("GetTeamRosterForSeason(atl) error = %v", err)
+	}
+	if _, err := client.GetRosterSeasons(ctx, "QUE"); err != nil {
+		t.Fatalf("GetRosterSeasons(QUE) error = %v", err)
+	}
+	if _, err := client.GetTeamRosterForSeason(ctx, "New York Rangers", 20102011); err != nil {
+		t.Fatalf("GetTeamRosterForSeason(New York Rangers) error = %v", err)
+	}
+	want := []string{"/v1/roster/ATL/19992000", "/v1/roster-season/QUE", "/v1/roster/NYR/20102011"}
+	if strings.Join(transport.paths, " ") != strings.Join(want, " ") {
+		t.Errorf("requested %v, want %v", transport.paths, want)
+	}
+
+	if _, err := client.GetRosterSeasons(ctx, "Atlanta Thrashers"); !errors.Is(err, nhl.ErrNotFound) {
+		t.Errorf("GetRosterSeasons(Atlanta Thrashers) error = %v, want ErrNotFound", err)
+	}
+}
+
+// slowTransport holds standings requests until release is closed, answering
+// everything else straight away
+type slowTransport struct {
+	started   chan struct{}
+	release   chan struct{}
+	standings atomic.Int32
+}
+
+func (s *slowTransport) Do(req *http.Request) (*http.Response, error) {
+	var body any = map[string]any{"data": []any{}}
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/standings/now"):
+		if s.standings.Add(1) == 1 {
+			close(s.started)
+		}
+		select {
+		case <-s.release:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		body = map[string]any{"standings": []any{map[string]any{
+			"teamAbbrev": map[string]any{"default": "NYR"},
+			"teamName":   map[string]any{"default": "New York Rangers"},
+		}}}
+	case strings.HasSuffix(req.URL.Path, "/team"):
+		body = map[string]any{"data": []any{map[string]any{"id": 3, "franchiseId": 10, "fullName": "New York Rangers", "triCode": "NYR"}}}
+	case strings.Contains(req.URL.Path, "/search/player"):
+		body = []any{}
+	}
+	data, _ := json.Marshal(body)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func TestTeamDirectoryConcurrentLoad(t *testing.T) {
+	transport := &slowTransport{started: make(chan struct{}), release: make(chan struct{})}
+	client := nhl.NewClient(nhl.WithHTTPClient(transport), nhl.WithRetryPolicy(nhl.NoRetry))
+
+	loaded := make(chan *nhl.TeamsResponse)
+	go func() {
+		teams, err := client.GetTeams(context.Background())
+		if err != nil {
+			t.Errorf("GetTeams() error = %v", err)
+		}
+		loaded <- teams
+	}()
+	<-transport.started
+
+	// A caller waiting for the load gives up when its own ctx ends
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	waited := make(chan error)
+	go func() {
+		_, err := client.GetTeamByIdentifier(ctx, "NYR")
+		waited <- err
+	}()
+	select {
+	case err := <-waited:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("GetTeamByIdentifier() while loading error = %v, want DeadlineExceeded", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("GetTeamByIdentifier() ignored its ctx while the directory loaded")
+	}
+
+	// Player search does not wait for the directory
+	searched := make(chan error)
+	go func() {
+		_, err := client.SearchPlayers(context.Background(), "panarin", nhl.PlayerSearchOptions{})
+		searched <- err
+	}()
+	select {
+	case <-searched:
+	case <-time.After(time.Second):
+		t.Fatal("SearchPlayers() blocked behind the team directory load")
+	}
+
+	close(transport.release)
+	teams := <-loaded
+	if teams == nil || len(teams.Teams) != 1 || teams.Teams[0].Abbreviation != "NYR" {
+		t.Fatalf("GetTeams() = %v, want NYR alone", teams)
+	}
+	team, err := client.GetTeamByIdentifier(context.Background(), "NYR")
+	if err != nil || team.ID != 3 {
+		t.Errorf("GetTeamByIdentifier() after loading = %v, %v", team, err)
+	}
+	if n := transport.standings.Load(); n != 1 {
+		t.Errorf("loaded the standings %d times, want once", n)
+	}
+}
+
+func TestPurgeCacheDuringTeamDirectoryLoad(t *testing.T) {
+	transport := &slowTransport{started: make(chan struct{}), release: make(chan struct{})}
+	client := nhl.NewClient(nhl.WithHTTPClient(transport), nhl.WithRetryPolicy(nhl.NoRetry))
+
+	loaded := make(chan error)
+	go func() {
+		_, err := client.GetTeams(context.Background())
+		loaded <- err
+	}()
+	<-transport.started
+
+	// The load still running at the purge must not store its directory
+	client.PurgeCache()
+	close(transport.release)
+	if err := <-loaded; err != nil {
+		t.Fatalf("GetTeams() error = %v", err)
+	}
+
+	if _, err := client.GetTeams(context.Background()); err != nil {
+		t.Fatalf("GetTeams() after purging error = %v", err)
+	}
+	if n := transport.standings.Load(); n != 2 {
+		t.Errorf("loaded the standings %d times, want twice", n)
+	}
+}
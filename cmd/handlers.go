@@ -1,46 +1,155 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	nhl "go-nhl/client"
 	"go-nhl/internal/display"
 	"go-nhl/internal/formatters"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
 // Schedule Commands
-func (c *Config) RunTodaysSchedule() error {
-	scores, err := c.Client.GetCurrentSchedule()
+func (c *Config) RunTodaysSchedule(ctx context.Context) error {
+	broadcasts, err := c.broadcastFilter()
 	if err != nil {
-		return fmt.Errorf("error getting current schedule: %v", err)
+		return err
 	}
+	scores, err := c.Client.GetCurrentSchedule(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting current schedule: %w", err)
+	}
+	scores.Games = broadcasts.Games(scores.Games)
 	fmt.Println("Games sorted by start time (earliest first - default):")
 	display.Games(scores)
 	return nil
 }
 
-func (c *Config) RunScheduleByDate(date string) error {
-	scores, err := c.Client.GetScheduleByDate(date, nhl.SortByDateDesc)
+func (c *Config) RunScheduleByDate(ctx context.Context, date string) error {
+	broadcasts, err := c.broadcastFilter()
+	if err != nil {
+		return err
+	}
+	scores, err := c.Client.GetScheduleByDate(ctx, date, nhl.SortByDateDesc)
 	if err != nil {
-		return fmt.Errorf("error getting schedule for date %s: %v", date, err)
+		return fmt.Errorf("error getting schedule for date %s: %w", date, err)
 	}
+	scores.Games = broadcasts.Games(scores.Games)
 	fmt.Println("\nGames sorted by start time (latest first):")
 	display.Games(scores)
 	return nil
 }
 
-func (c *Config) RunTeamSchedule(teamIdentifier string) error {
-	team, err := c.Client.GetTeamByIdentifier(teamIdentifier)
+// RunLeagueSchedule shows the league's games from -from to -to, a week
+// when -to is not given, filtered by -team, -home-away, -state,
+// -playoffs and the broadcast filters
+func (c *Config) RunLeagueSchedule(ctx context.Context) error {
+	start := c.From
+	if start == "" {
+		start = c.Date
+	}
+	if start == "" {
+		start = time.Now().Format("2006-01-02")
+	}
+	end := c.To
+	if end == "" {
+		from, err := time.Parse("2006-01-02", start)
+		if err != nil {
+			return fmt.Errorf("invalid start date %q: %w", start, err)
+		}
+		end = from.AddDate(0, 0, 6).Format("2006-01-02")
+	}
+
+	homeAway, err := nhl.ParseHomeAway(c.HomeAway)
+	if err != nil {
+		return err
+	}
+	if homeAway != nhl.HomeOrAway && c.Team == "" {
+		return fmt.Errorf("-home-away needs -team")
+	}
+	broadcasts, err := c.broadcastFilter()
+	if err != nil {
+		return err
+	}
+	filter := &nhl.ScheduleFilter{
+		Teams:      splitList(strings.ToUpper(c.Team)),
+		HomeAway:   homeAway,
+		Broadcasts: broadcasts,
+	}
+	for _, s := range splitList(c.States) {
+		state, err := nhl.ParseGameState(s)
+		if err != nil {
+			return err
+		}
+		filter.GameStates = append(filter.GameStates, state)
+	}
+	if c.Playoffs {
+		filter.GameTypes = []nhl.GameType{nhl.GameTypePlayoffs}
+	}
+
+	schedule, err := c.Client.GetLeagueSchedule(ctx, start, end, filter)
 	if err != nil {
-		return fmt.Errorf("failed to get team: %v", err)
+		return fmt.Errorf("error getting schedule from %s to %s: %w", start, end, err)
+	}
+	display.LeagueSchedule(schedule)
+	return nil
+}
+
+// broadcastFilter builds the filter given by -country, -network and
+// -market
+func (c *Config) broadcastFilter() (*nhl.BroadcastFilter, error) {
+	filter := &nhl.BroadcastFilter{
+		Countries: splitList(strings.ToUpper(c.Country)),
+		Networks:  splitList(c.Network),
+	}
+	for _, m := range splitList(c.Market) {
+		market, err := nhl.ParseMarket(m)
+		if err != nil {
+			return nil, err
+		}
+		filter.Markets = append(filter.Markets, market)
+	}
+	return filter, nil
+}
+
+// splitList splits a comma separated flag value, dropping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (c *Config) RunTeamSchedule(ctx context.Context, teamIdentifier string) error {
+	team, err := c.Client.GetTeamByIdentifier(ctx, teamIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to get team: %w", err)
 	}
 
 	seasonID := formatters.GetCurrentSeasonID()
-	schedule, err := c.Client.GetTeamSchedule(team, seasonID)
+	broadcastFilter, err := c.broadcastFilter()
 	if err != nil {
-		return fmt.Errorf("failed to get schedule: %v", err)
+		return err
+	}
+	if c.Broadcasts || !broadcastFilter.IsZero() {
+		broadcasts, err := c.Client.GetTeamBroadcasts(ctx, team, seasonID, broadcastFilter)
+		if err != nil {
+			return fmt.Errorf("failed to get schedule: %w", err)
+		}
+		display.TeamBroadcasts(team.Name.Default, broadcasts)
+		return nil
+	}
+
+	schedule, err := c.Client.GetTeamSchedule(ctx, team, seasonID)
+	if err != nil {
+		return fmt.Errorf("failed to get schedule: %w", err)
 	}
 
 	fmt.Printf("Schedule for %s (%d-%d):\n", team.Name.Default, seasonID/10000, (seasonID/10000)+1)
@@ -67,10 +176,14 @@ func (c *Config) RunTeamSchedule(teamIdentifier string) error {
 }
 
 // Player Commands
-func (c *Config) RunPlayerSearch(searchName string) error {
-	players, err := c.Client.SearchPlayer(searchName)
+func (c *Config) RunPlayerSearch(ctx context.Context, searchName string) error {
+	opts, err := c.searchOptions()
+	if err != nil {
+		return err
+	}
+	players, err := c.Client.SearchPlayers(ctx, searchName, opts)
 	if err != nil {
-		return fmt.Errorf("error searching for player %s: %v", searchName, err)
+		return fmt.Errorf("error searching for player %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -80,30 +193,28 @@ func (c *Config) RunPlayerSearch(searchName string) error {
 
 	fmt.Printf("\nFound %d players matching '%s':\n", len(players), searchName)
 	for i, player := range players {
-		fmt.Printf("%d. %s %s (#%d) - %s %s\n",
-			i+1,
-			player.FirstName.Default,
-			player.LastName.Default,
-			player.JerseyNumber,
-			player.TeamAbbrev,
-			player.Position)
+		fmt.Printf("%d. %s\n", i+1, formatSearchResult(player))
 	}
 
 	// Get stats for the first player found
 	player := players[0]
-	stats, err := c.Client.GetFilteredPlayerStats(player.PlayerID, nil)
+	stats, err := c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, nil)
 	if err != nil {
-		return fmt.Errorf("error getting stats for player %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting stats for player %d: %w", player.PlayerID, err)
 	}
 
 	display.SeasonStats(stats, nhl.GameTypeRegularSeason)
 	return nil
 }
 
-func (c *Config) RunSkaterSearch(searchName string) error {
-	players, err := c.Client.SearchPlayer(searchName)
+func (c *Config) RunSkaterSearch(ctx context.Context, searchName string) error {
+	opts, err := c.searchOptions()
 	if err != nil {
-		return fmt.Errorf("error searching for skater %s: %v", searchName, err)
+		return err
+	}
+	players, err := c.Client.SearchPlayers(ctx, searchName, opts)
+	if err != nil {
+		return fmt.Errorf("error searching for skater %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -114,7 +225,7 @@ func (c *Config) RunSkaterSearch(searchName string) error {
 	// Filter for skaters only
 	var skaters []nhl.PlayerSearchResult
 	for _, player := range players {
-		if player.Position != "G" {
+		if !player.Position.IsGoalie() {
 			skaters = append(skaters, player)
 		}
 	}
@@ -126,13 +237,7 @@ func (c *Config) RunSkaterSearch(searchName string) error {
 
 	fmt.Printf("\nFound %d skaters matching '%s':\n", len(skaters), searchName)
 	for i, player := range skaters {
-		fmt.Printf("%d. %s %s (#%d) - %s %s\n",
-			i+1,
-			player.FirstName.Default,
-			player.LastName.Default,
-			player.JerseyNumber,
-			player.TeamAbbrev,
-			player.Position)
+		fmt.Printf("%d. %s\n", i+1, formatSearchResult(player))
 	}
 
 	// Get stats for the first skater found
@@ -140,30 +245,35 @@ func (c *Config) RunSkaterSearch(searchName string) error {
 
 	// Get regular season stats
 	fmt.Println("\nRegular Season Stats:")
-	stats, err := c.Client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err := c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypeRegularSeason,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting regular season stats for skater %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting regular season stats for skater %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypeRegularSeason)
 
 	// Get playoff stats
 	fmt.Println("\nPlayoff Stats:")
-	stats, err = c.Client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err = c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypePlayoffs,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting playoff stats for skater %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting playoff stats for skater %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypePlayoffs)
 	return nil
 }
 
-func (c *Config) RunGoalieSearch(searchName string) error {
-	players, err := c.Client.SearchPlayer(searchName)
+func (c *Config) RunGoalieSearch(ctx context.Context, searchName string) error {
+	opts, err := c.searchOptions()
+	if err != nil {
+		return err
+	}
+	opts.Position = nhl.PositionGoalie
+	players, err := c.Client.SearchPlayers(ctx, searchName, opts)
 	if err != nil {
-		return fmt.Errorf("error searching for goalie %s: %v", searchName, err)
+		return fmt.Errorf("error searching for goalie %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -174,7 +284,7 @@ func (c *Config) RunGoalieSearch(searchName string) error {
 	// Filter for goalies only
 	var goalies []nhl.PlayerSearchResult
 	for _, player := range players {
-		if player.Position == "G" {
+		if player.Position.IsGoalie() {
 			goalies = append(goalies, player)
 		}
 	}
@@ -186,13 +296,7 @@ func (c *Config) RunGoalieSearch(searchName string) error {
 
 	fmt.Printf("\nFound %d goalies matching '%s':\n", len(goalies), searchName)
 	for i, player := range goalies {
-		fmt.Printf("%d. %s %s (#%d) - %s %s\n",
-			i+1,
-			player.FirstName.Default,
-			player.LastName.Default,
-			player.JerseyNumber,
-			player.TeamAbbrev,
-			player.Position)
+		fmt.Printf("%d. %s\n", i+1, formatSearchResult(player))
 	}
 
 	// Get stats for the first goalie found
@@ -200,30 +304,34 @@ func (c *Config) RunGoalieSearch(searchName string) error {
 
 	// Get regular season stats
 	fmt.Println("\nRegular Season Stats:")
-	stats, err := c.Client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err := c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypeRegularSeason,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting regular season stats for goalie %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting regular season stats for goalie %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypeRegularSeason)
 
 	// Get playoff stats
 	fmt.Println("\nPlayoff Stats:")
-	stats, err = c.Client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err = c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypePlayoffs,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting playoff stats for goalie %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting playoff stats for goalie %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypePlayoffs)
 	return nil
 }
 
-func (c *Config) RunSeasonStats(searchName string) error {
-	players, err := c.Client.SearchPlayer(searchName)
+func (c *Config) RunSeasonStats(ctx context.Context, searchName string) error {
+	opts, err := c.searchOptions()
 	if err != nil {
-		return fmt.Errorf("error searching for player %s: %v", searchName, err)
+		return err
+	}
+	players, err := c.Client.SearchPlayers(ctx, searchName, opts)
+	if err != nil {
+		return fmt.Errorf("error searching for player %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -238,9 +346,9 @@ func (c *Config) RunSeasonStats(searchName string) error {
 		player.LastName.Default)
 
 	// Get all seasons to show what's available
-	allStats, err := c.Client.GetFilteredPlayerStats(player.PlayerID, nil)
+	allStats, err := c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, nil)
 	if err != nil {
-		return fmt.Errorf("error getting player stats: %v", err)
+		return fmt.Errorf("error getting player stats: %w", err)
 	}
 
 	fmt.Println("\nAvailable NHL Seasons:")
@@ -248,7 +356,7 @@ func (c *Config) RunSeasonStats(searchName string) error {
 		fmt.Printf("- %d-%d (%s): %d games played, %d goals, %d points\n",
 			season.Season/10000,
 			(season.Season/10000)+1,
-			display.GetGameTypeName(nhl.GameType(season.GameTypeID)),
+			display.GetGameTypeName(season.GameTypeID),
 			season.GamesPlayed,
 			season.Goals,
 			season.Points)
@@ -275,7 +383,7 @@ func (c *Config) RunSeasonStats(searchName string) error {
 			(s.seasonID/10000)+1,
 			display.GetGameTypeName(s.gameType))
 
-		stats, err := c.Client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+		stats, err := c.Client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 			GameType: s.gameType,
 			SeasonID: s.seasonID,
 		})
@@ -295,8 +403,204 @@ func (c *Config) RunSeasonStats(searchName string) error {
 	return nil
 }
 
+func (c *Config) RunPlayerGameLog(ctx context.Context, searchName string) error {
+	opts, err := c.searchOptions()
+	if err != nil {
+		return err
+	}
+	players, err := c.Client.SearchPlayers(ctx, searchName, opts)
+	if err != nil {
+		return fmt.Errorf("error searching for player %s: %w", searchName, err)
+	}
+
+	if len(players) == 0 {
+		fmt.Printf("No players found matching '%s'\n", searchName)
+		return nil
+	}
+
+	gameType := nhl.GameTypeRegularSeason
+	if c.Playoffs {
+		gameType = nhl.GameTypePlayoffs
+	}
+
+	player := players[0]
+	gameLog, err := c.Client.GetPlayerGameLog(ctx, player.PlayerID, c.Season, gameType)
+	if err != nil {
+		return fmt.Errorf("error getting game log for player %d: %w", player.PlayerID, err)
+	}
+
+	gameLog, err = gameLog.Between(c.From, c.To)
+	if err != nil {
+		return err
+	}
+
+	display.GameLog(gameLog, player.FirstName.Default+" "+player.LastName.Default)
+	return nil
+}
+
+func (c *Config) RunClubStats(ctx context.Context, teamIdentifier string) error {
+	team, err := c.Client.GetTeamByIdentifier(ctx, teamIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if c.ListSeasons {
+		seasons, err := c.Client.GetClubStatsSeasons(ctx, team.Abbreviation)
+		if err != nil {
+			return fmt.Errorf("error getting club stats seasons for %s: %w", team.Abbreviation, err)
+		}
+		display.ClubStatsSeasons(seasons, team.Name.Default)
+		return nil
+	}
+
+	gameType := nhl.GameTypeRegularSeason
+	if c.Playoffs {
+		gameType = nhl.GameTypePlayoffs
+	}
+
+	stats, err := c.Client.GetClubStats(ctx, team.Abbreviation, c.Season, gameType)
+	if err != nil {
+		return fmt.Errorf("error getting club stats for %s: %w", team.Abbreviation, err)
+	}
+
+	sortKey := c.Sort
+	if sortKey == "" {
+		sortKey = "points"
+	}
+	if err := stats.Sort(sortKey); err != nil {
+		return err
+	}
+
+	display.ClubStats(stats, team.Name.Default)
+	return nil
+}
+
+func (c *Config) RunPlayoffBracket(ctx context.Context) error {
+	seasonID := c.Season
+	if seasonID == 0 {
+		seasonID = formatters.GetCurrentSeasonID()
+	}
+
+	if c.Series != "" {
+		series, err := c.Client.GetPlayoffSeries(ctx, seasonID, c.Series)
+		if err != nil {
+			return fmt.Errorf("error getting playoff series %s: %w", c.Series, err)
+		}
+		display.PlayoffSeries(series)
+		return nil
+	}
+
+	year := seasonID % 10000
+	bracket, err := c.Client.GetPlayoffBracket(ctx, year)
+	if err != nil {
+		return fmt.Errorf("error getting %d playoff bracket: %w", year, err)
+	}
+	display.PlayoffBracket(bracket, year)
+	return nil
+}
+
+func (c *Config) RunDraftClass(ctx context.Context) error {
+	draft, err := c.Client.GetDraftPicks(ctx, c.Year, c.Round)
+	if err != nil {
+		return fmt.Errorf("error getting draft picks: %w", err)
+	}
+
+	team, err := c.singleTeam()
+	if err != nil {
+		return err
+	}
+	picks := draft.Picks
+	if team != "" {
+		picks = draft.ByTeam(team)
+	}
+	display.DraftPicks(picks, draft.DraftYear)
+	return nil
+}
+
+func (c *Config) RunPlayerDraft(ctx context.Context, searchName string) error {
+	opts, err := c.searchOptions()
+	if err != nil {
+		return err
+	}
+	players, err := c.Client.SearchPlayers(ctx, searchName, opts)
+	if err != nil {
+		return fmt.Errorf("error searching for player %s: %w", searchName, err)
+	}
+
+	if len(players) == 0 {
+		fmt.Printf("No players found matching '%s'\n", searchName)
+		return nil
+	}
+
+	player := players[0]
+	details, err := c.Client.GetPlayerDraftDetails(ctx, player.PlayerID)
+	if err != nil {
+		return fmt.Errorf("error getting draft details for player %d: %w", player.PlayerID, err)
+	}
+
+	display.DraftDetails(details, player.FirstName.Default+" "+player.LastName.Default)
+	return nil
+}
+
+// singleTeam returns the -team abbreviation for commands that take one
+// team, rejecting the comma separated teams only the slate takes
+func (c *Config) singleTeam() (string, error) {
+	if strings.Contains(c.Team, ",") {
+		return "", fmt.Errorf("-team %q takes one team here, comma separated teams are only for -slate", c.Team)
+	}
+	return strings.TrimSpace(c.Team), nil
+}
+
+// searchOptions returns the player search filters set on the command line
+func (c *Config) searchOptions() (nhl.PlayerSearchOptions, error) {
+	team, err := c.singleTeam()
+	if err != nil {
+		return nhl.PlayerSearchOptions{}, err
+	}
+	opts := nhl.PlayerSearchOptions{
+		ActiveOnly: c.ActiveOnly,
+		Team:       team,
+	}
+	if c.Position != "" {
+		position, err := nhl.ParsePosition(c.Position)
+		if err != nil {
+			return opts, err
+		}
+		opts.Position = position
+	}
+	return opts, nil
+}
+
+// formatSearchResult describes a player found by a search on one line
+func formatSearchResult(player nhl.PlayerSearchResult) string {
+	line := fmt.Sprintf("%s %s (#%d) - %s %s",
+		player.FirstName.Default,
+		player.LastName.Default,
+		player.JerseyNumber,
+		player.TeamAbbrev,
+		player.Position)
+	if !player.Active {
+		line = fmt.Sprintf("%s %s - %s, last played for %s",
+			player.FirstName.Default,
+			player.LastName.Default,
+			player.Position,
+			player.LastTeamAbbrev)
+		if player.LastSeasonID > 0 {
+			line += " in " + formatters.FormatSeasonID(player.LastSeasonID)
+		}
+	}
+	if player.BirthYear > 0 {
+		line += fmt.Sprintf(", born %d", player.BirthYear)
+	}
+	return line
+}
+
 // Team Commands
-func (c *Config) RunTeamRoster() error {
+func (c *Config) RunTeamRoster(ctx context.Context) error {
+	if c.Name != "" {
+		return c.RunTeamRosterForSeason(ctx, c.Name)
+	}
+
 	// Example: Get roster for teams using different identifier types
 	identifiers := []string{
 		"DAL",                // by abbreviation
@@ -304,7 +608,7 @@ func (c *Config) RunTeamRoster() error {
 		"6",                  // by ID (Boston Bruins)
 	}
 	for _, identifier := range identifiers {
-		roster, err := c.Client.GetTeamRoster(identifier)
+		roster, err := c.Client.GetTeamRoster(ctx, identifier)
 		if err != nil {
 			fmt.Printf("Error getting roster for %s: %v\n", identifier, err)
 			continue
@@ -315,11 +619,46 @@ func (c *Config) RunTeamRoster() error {
 	return nil
 }
 
+func (c *Config) RunTeamRosterForSeason(ctx context.Context, teamIdentifier string) error {
+	// Relocated franchises, such as ATL, are not in the team directory but
+	// the roster calls still find them by tricode
+	abbrev, name := strings.ToUpper(teamIdentifier), strings.ToUpper(teamIdentifier)
+	if team, err := c.Client.GetTeamByIdentifier(ctx, teamIdentifier); err == nil {
+		abbrev, name = team.Abbreviation, team.Name.Default
+	} else if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if c.ListSeasons {
+		seasons, err := c.Client.GetRosterSeasons(ctx, teamIdentifier)
+		if err != nil {
+			return fmt.Errorf("error getting roster seasons for %s: %w", abbrev, err)
+		}
+		fmt.Printf("\nSeasons with rosters for %s\n", name)
+		for _, season := range seasons {
+			fmt.Println(formatters.FormatSeasonID(season))
+		}
+		return nil
+	}
+
+	roster, err := c.Client.GetTeamRosterForSeason(ctx, teamIdentifier, c.Season)
+	if err != nil {
+		return fmt.Errorf("error getting roster for %s: %w", abbrev, err)
+	}
+
+	label := abbrev
+	if c.Season != 0 {
+		label = fmt.Sprintf("%s (%s)", abbrev, formatters.FormatSeasonID(c.Season))
+	}
+	display.Roster(roster, label)
+	return nil
+}
+
 // Standings Commands
-func (c *Config) RunCurrentStandings() error {
-	standings, err := c.Client.GetStandings()
+func (c *Config) RunCurrentStandings(ctx context.Context) error {
+	standings, err := c.Client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting current standings: %v", err)
+		return fmt.Errorf("error getting current standings: %w", err)
 	}
 
 	fmt.Println("\nCurrent NHL Standings:")
@@ -327,10 +666,10 @@ func (c *Config) RunCurrentStandings() error {
 	return nil
 }
 
-func (c *Config) RunStandingsByDate(date string) error {
-	standings, err := c.Client.GetStandingsByDate(date)
+func (c *Config) RunStandingsByDate(ctx context.Context, date string) error {
+	standings, err := c.Client.GetStandingsByDate(ctx, date)
 	if err != nil {
-		return fmt.Errorf("error getting standings for date %s: %v", date, err)
+		return fmt.Errorf("error getting standings for date %s: %w", date, err)
 	}
 
 	fmt.Printf("\nNHL Standings for %s:\n", date)
@@ -338,10 +677,10 @@ func (c *Config) RunStandingsByDate(date string) error {
 	return nil
 }
 
-func (c *Config) RunLeagueStandings() error {
-	standings, err := c.Client.GetStandings()
+func (c *Config) RunLeagueStandings(ctx context.Context) error {
+	standings, err := c.Client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting standings: %v", err)
+		return fmt.Errorf("error getting standings: %w", err)
 	}
 
 	// Sort all teams by points, regulation wins, goal differential
@@ -383,10 +722,10 @@ func (c *Config) RunLeagueStandings() error {
 	return nil
 }
 
-func (c *Config) RunConferenceStandings() error {
-	standings, err := c.Client.GetStandings()
+func (c *Config) RunConferenceStandings(ctx context.Context) error {
+	standings, err := c.Client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting standings: %v", err)
+		return fmt.Errorf("error getting standings: %w", err)
 	}
 
 	// Group teams by conference
@@ -444,10 +783,10 @@ func (c *Config) RunConferenceStandings() error {
 	return nil
 }
 
-func (c *Config) RunDivisionStandings() error {
-	standings, err := c.Client.GetStandings()
+func (c *Config) RunDivisionStandings(ctx context.Context) error {
+	standings, err := c.Client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting standings: %v", err)
+		return fmt.Errorf("error getting standings: %w", err)
 	}
 
 	// Group teams by division
@@ -506,46 +845,116 @@ func (c *Config) RunDivisionStandings() error {
 }
 
 // Game Commands
-func (c *Config) RunGameDetails() error {
+func (c *Config) RunGameDetails(ctx context.Context) error {
+	game := c.GameID
+	if game == "" {
+		game = defaultGame
+	}
+	gameID, err := c.Client.ResolveGameID(ctx, game)
+	if err != nil {
+		return fmt.Errorf("error finding game %q: %w", game, err)
+	}
+
 	// Get basic game details
-	details, err := c.Client.GetGameDetails(c.GameID)
+	details, err := c.Client.GetGameDetails(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("error getting game details: %v", err)
+		return fmt.Errorf("error getting game details: %w", err)
 	}
 	if details == nil {
-		return fmt.Errorf("no game details found for ID: %d", c.GameID)
+		return fmt.Errorf("no game details found for ID: %d", gameID)
 	}
 
 	// Get boxscore
-	boxscore, err := c.Client.GetGameBoxscore(c.GameID)
+	boxscore, err := c.Client.GetGameBoxscore(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("error getting game boxscore: %v", err)
+		return fmt.Errorf("error getting game boxscore: %w", err)
 	}
 	if boxscore == nil {
-		return fmt.Errorf("no boxscore found for ID: %d", c.GameID)
+		return fmt.Errorf("no boxscore found for ID: %d", gameID)
+	}
+
+	// The right rail only adds to the details, so carry on without it when
+	// the API has none
+	rail, err := c.Client.GetGameRightRail(ctx, gameID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var apiErr *nhl.APIError
+		if !errors.As(err, &apiErr) {
+			return fmt.Errorf("error getting game right rail: %w", err)
+		}
 	}
 
 	// Display game details with boxscore
-	display.GameDetails(details, boxscore)
+	display.GameDetails(details, boxscore, rail)
 
 	// Display boxscore
 	display.GameBoxscore(boxscore)
 
 	// Get play-by-play
-	pbp, err := c.Client.GetGamePlayByPlay(c.GameID)
+	pbp, err := c.Client.GetGamePlayByPlay(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("error getting play-by-play: %v", err)
+		return fmt.Errorf("error getting play-by-play: %w", err)
 	}
 	if pbp == nil {
-		return fmt.Errorf("no play-by-play found for ID: %d", c.GameID)
+		return fmt.Errorf("no play-by-play found for ID: %d", gameID)
+	}
+	filter, err := c.playFilter(pbp)
+	if err != nil {
+		return err
 	}
-	display.GamePlayByPlay(pbp)
+	display.GamePlayByPlay(pbp, filter)
 
 	return nil
 }
 
-func (c *Config) RunLiveGameUpdates() error {
-	updates, err := c.Client.GetLiveGameUpdates()
+// playFilter builds the play-by-play filter from the flags, finding the
+// -name player on the game's rosters
+func (c *Config) playFilter(pbp *nhl.PlayByPlayResponse) (*nhl.PlayFilter, error) {
+	team, err := c.singleTeam()
+	if err != nil {
+		return nil, err
+	}
+	filter := &nhl.PlayFilter{Team: team}
+	if team != "" && pbp.TeamID(team) == 0 {
+		return nil, fmt.Errorf("team %q did not play in the game", team)
+	}
+	if c.Name != "" {
+		spots := pbp.FindPlayers(c.Name)
+		if len(spots) == 0 {
+			return nil, fmt.Errorf("no player %q found in the game", c.Name)
+		}
+		for _, spot := range spots {
+			filter.PlayerIDs = append(filter.PlayerIDs, spot.PlayerID)
+		}
+	}
+	for _, p := range splitList(c.Periods) {
+		period, err := strconv.Atoi(p)
+		if err != nil || period < 1 {
+			return nil, fmt.Errorf("invalid period %q, want a number such as 1 or 4 for overtime", p)
+		}
+		filter.Periods = append(filter.Periods, period)
+	}
+	for _, e := range splitList(c.Events) {
+		eventType, err := nhl.ParseEventType(e)
+		if err != nil {
+			return nil, err
+		}
+		filter.Types = append(filter.Types, eventType)
+	}
+	for _, s := range splitList(c.Strengths) {
+		strength, err := nhl.ParseStrength(s)
+		if err != nil {
+			return nil, err
+		}
+		filter.Strengths = append(filter.Strengths, strength)
+	}
+	return filter, nil
+}
+
+func (c *Config) RunLiveGameUpdates(ctx context.Context) error {
+	updates, err := c.Client.GetLiveGameUpdates(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get live game updates: %w", err)
 	}
@@ -554,22 +963,28 @@ func (c *Config) RunLiveGameUpdates() error {
 	return nil
 }
 
-func (c *Config) RunLeagueLeaders() error {
-	// Get current season leaders
-	leaders, err := c.Client.GetStatsLeaders(0)
+func (c *Config) RunLeagueLeaders(ctx context.Context) error {
+	categories, err := nhl.ParseLeaderCategories(c.Categories)
 	if err != nil {
-		return fmt.Errorf("error getting stats leaders: %v", err)
+		return err
 	}
 
-	display.StatsLeaders(leaders, 0)
+	query := nhl.LeadersQuery{
+		SeasonID:   c.Season,
+		GameType:   nhl.GameTypeRegularSeason,
+		Categories: categories,
+		Limit:      c.Limit,
+	}
+	if c.Playoffs {
+		query.GameType = nhl.GameTypePlayoffs
+	}
 
-	// Get previous season leaders
-	prevSeasonLeaders, err := c.Client.GetStatsLeaders(20222023)
+	leaders, err := c.Client.GetLeaders(ctx, query)
 	if err != nil {
-		return fmt.Errorf("error getting previous season stats leaders: %v", err)
+		return fmt.Errorf("error getting stats leaders: %w", err)
 	}
 
-	display.StatsLeaders(prevSeasonLeaders, 20222023)
+	display.Leaders(leaders, query.SeasonID, query.GameType)
 	return nil
 }
 
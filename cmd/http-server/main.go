@@ -1,13 +1,17 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
+	nhl "go-nhl/client"
+	"go-nhl/internal/config"
 	nhlserver "go-nhl/mcp/server"
 )
 
@@ -22,7 +26,34 @@ func main() {
 		baseURL = "http://localhost" + addr
 	}
 
-	// Create the MCP server
+	// Configure the NHL API client shared by the tool handlers
+	clientConfig, err := config.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	clientConfig.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+	client, err := clientConfig.NewClient(
+		nhl.WithRetryHook(func(e nhl.RetryEvent) {
+			log.Printf("retrying %s after attempt %d (status %d) in %s: %v", e.URL, e.Attempt, e.StatusCode, e.Wait, e.Err)
+		}),
+		nhl.WithRateLimitHook(func(host string, wait time.Duration) {
+			log.Printf("rate limited: waiting %s for %s", wait, host)
+		}),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	nhlserver.SetClient(client)
+
+	log.Printf("NHL MCP server listening on %s", addr)
+	log.Printf("Streamable HTTP endpoint: %s/mcp", baseURL)
+	log.Printf("SSE endpoint: %s/sse", baseURL)
+	log.Fatal(http.ListenAndServe(addr, newHandler(newMCPServer(), baseURL)))
+}
+
+// newMCPServer creates the MCP server with all the NHL tools registered
+func newMCPServer() *server.MCPServer {
 	s := server.NewMCPServer(
 		"NHL",
 		"1.0.0",
@@ -32,19 +63,58 @@ func main() {
 
 	// Register all the NHL tools
 	slateTool := mcp.NewTool("nhl-slate",
-		mcp.WithDescription("Get slate of games for a given date"),
+		mcp.WithDescription("Get slate of games for a given date, optionally only the games on matching TV broadcasts"),
 		mcp.WithString("date",
 			mcp.Required(),
 			mcp.Description("Date (YYYY-MM-DD format)"),
 		),
+		mcp.WithString("country",
+			mcp.Description("Comma separated broadcast country codes, such as US or CA"),
+		),
+		mcp.WithString("network",
+			mcp.Description("Comma separated networks, such as ESPN,SN"),
+		),
+		mcp.WithString("market",
+			mcp.Description("Comma separated broadcast markets: national, home or away"),
+		),
 	)
 
 	playerTool := mcp.NewTool("nhl-player",
-		mcp.WithDescription("Get player info and stats"),
+		mcp.WithDescription("Search current and retired players by name and get the best match's stats"),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Player name, accents and small typos are ignored"),
+		),
+		mcp.WithBoolean("active",
+			mcp.Description("Only find players currently on an NHL roster"),
+		),
+		mcp.WithString("position",
+			mcp.Description("Position code: C, L, R, D, G, or F for any forward"),
+		),
+		mcp.WithString("team",
+			mcp.Description("Team abbreviation, matching the player's current or last team"),
+		),
+	)
+
+	gameLogTool := mcp.NewTool("nhl-player-gamelog",
+		mcp.WithDescription("Get a player's game-by-game stats for a season, with totals"),
 		mcp.WithString("name",
 			mcp.Required(),
 			mcp.Description("Player name"),
 		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20242025, default: current season)"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Game type: regular or playoffs (default: regular)"),
+			mcp.DefaultString("regular"),
+		),
+		mcp.WithString("from",
+			mcp.Description("First date to include (YYYY-MM-DD format)"),
+		),
+		mcp.WithString("to",
+			mcp.Description("Last date to include (YYYY-MM-DD format)"),
+		),
 	)
 
 	standingsTool := mcp.NewTool("nhl-standings",
@@ -59,11 +129,17 @@ func main() {
 	)
 
 	rosterTool := mcp.NewTool("nhl-roster",
-		mcp.WithDescription("Get team roster"),
+		mcp.WithDescription("Get a team roster, current or for a past season"),
 		mcp.WithString("team",
 			mcp.Required(),
 			mcp.Description("Team abbreviation"),
 		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20102011, default: current roster)"),
+		),
+		mcp.WithBoolean("listSeasons",
+			mcp.Description("List the seasons the team has rosters for instead"),
+		),
 	)
 
 	scheduleTool := mcp.NewTool("nhl-schedule",
@@ -78,20 +154,107 @@ func main() {
 	)
 
 	leadersTool := mcp.NewTool("nhl-leaders",
-		mcp.WithDescription("Get leaders"),
-		mcp.WithString("seasonID",
-			mcp.Description("Season ID (example: 20242025)"),
+		mcp.WithDescription("Get skater and goalie stats leaders by category"),
+		mcp.WithString("type",
+			mcp.Description("Leader type: skater, goalie or all (default: all)"),
+			mcp.DefaultString("all"),
+		),
+		mcp.WithString("categories",
+			mcp.Description("Comma separated categories, overriding type: points, goals, assists, goalsPp, goalsSh, plusMinus, faceoffLeaders, toi, penaltyMins, wins, savePctg, goalsAgainstAverage, shutouts"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Players per category (-1 for all, default: 5)"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Game type: regular or playoffs (default: regular)"),
+			mcp.DefaultString("regular"),
+		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20242025, default: current season)"),
+		),
+	)
+
+	clubStatsTool := mcp.NewTool("nhl-club-stats",
+		mcp.WithDescription("Get every skater's and goalie's stats for a team in a season"),
+		mcp.WithString("team",
+			mcp.Required(),
+			mcp.Description("Team name or abbreviation"),
+		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20242025, default: current season)"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Game type: regular or playoffs (default: regular)"),
+			mcp.DefaultString("regular"),
+		),
+		mcp.WithString("sort",
+			mcp.Description("Stat to sort by, best first: points, goals, assists, plusMinus, penaltyMinutes, powerPlayGoals, shots, timeOnIce, gamesPlayed, wins, savePercentage, goalsAgainstAverage, shutouts or name (default: points)"),
+			mcp.DefaultString("points"),
+		),
+		mcp.WithBoolean("listSeasons",
+			mcp.Description("List the seasons and game types the team has stats for instead"),
+		),
+	)
+
+	playoffsTool := mcp.NewTool("nhl-playoffs",
+		mcp.WithDescription("Get the playoff bracket, or one playoff series with its games"),
+		mcp.WithNumber("year",
+			mcp.Description("Year the playoffs were played in (example: 2024, default: current season)"),
+		),
+		mcp.WithString("series",
+			mcp.Description("Series letter, A to O, to get that series and its games instead of the bracket"),
+		),
+	)
+
+	draftTool := mcp.NewTool("nhl-draft",
+		mcp.WithDescription("Get the picks of an NHL draft, Central Scouting's prospect rankings, or where a player was drafted"),
+		mcp.WithNumber("year",
+			mcp.Description("Draft year (example: 2015, default: current season)"),
+		),
+		mcp.WithNumber("round",
+			mcp.Description("Draft round (default: all rounds)"),
+		),
+		mcp.WithString("team",
+			mcp.Description("Only return picks made by this team abbreviation"),
+		),
+		mcp.WithString("player",
+			mcp.Description("Player name, to get where they were drafted instead"),
+		),
+		mcp.WithString("rankings",
+			mcp.Description("Prospect list to get instead: north-american-skater, international-skater, north-american-goalie or international-goalie"),
+		),
+	)
+
+	leagueScheduleTool := mcp.NewTool("nhl-league-schedule",
+		mcp.WithDescription("Get every game across the league over a range of dates, grouped by date, such as all games this week"),
+		mcp.WithString("startDate",
+			mcp.Description("First date (YYYY-MM-DD format, default: Monday of this week)"),
+		),
+		mcp.WithString("endDate",
+			mcp.Description("Last date (YYYY-MM-DD format, default: six days after startDate)"),
+		),
+		mcp.WithString("teams",
+			mcp.Description("Comma separated team abbreviations to only return games of (example: NYR,BOS)"),
+		),
+		mcp.WithString("homeAway",
+			mcp.Description("Only return games where teams are at home or away: home or away"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Only return regular season or playoff games: regular or playoffs"),
+		),
+		mcp.WithString("gameStates",
+			mcp.Description("Comma separated game states to only return (example: FUT,LIVE,OFF)"),
 		),
 	)
 
 	gameTool := mcp.NewTool("nhl-game",
-		mcp.WithDescription("Get detailed game information including boxscore, play-by-play, and game story"),
-		mcp.WithNumber("gameId",
+		mcp.WithDescription("Get detailed game information including boxscore, play-by-play, game story and the season series"),
+		mcp.WithAny("gameId",
 			mcp.Required(),
-			mcp.Description("Game ID"),
+			mcp.Description("Game ID as a number such as 2023020750, or a string such as 2023-02-0750, NYR@CHI 2024-02-09 or NYR 2024-02-09"),
 		),
 		mcp.WithString("include",
-			mcp.Description("What to include: details, boxscore, plays, story, or all (default: details)"),
+			mcp.Description("What to include: details, boxscore, plays, story, rail (season series, team stats, linescore, officials and scratches), or all (default: details)"),
 			mcp.DefaultString("details"),
 		),
 	)
@@ -106,23 +269,34 @@ func main() {
 
 	highlightsTool := mcp.NewTool("nhl-highlights",
 		mcp.WithDescription("Get video highlights for a specific game"),
-		mcp.WithNumber("gameId",
+		mcp.WithAny("gameId",
 			mcp.Required(),
-			mcp.Description("Game ID"),
+			mcp.Description("Game ID as a number such as 2023020750, or a string such as 2023-02-0750, NYR@CHI 2024-02-09 or NYR 2024-02-09"),
 		),
 	)
 
 	s.AddTool(slateTool, nhlserver.SlateHandler)
 	s.AddTool(playerTool, nhlserver.PlayerHandler)
+	s.AddTool(gameLogTool, nhlserver.GameLogHandler)
 	s.AddTool(standingsTool, nhlserver.StandingsHandler)
 	s.AddTool(rosterTool, nhlserver.RosterHandler)
 	s.AddTool(scheduleTool, nhlserver.ScheduleHandler)
 	s.AddTool(leadersTool, nhlserver.LeadersHandler)
+	s.AddTool(clubStatsTool, nhlserver.ClubStatsHandler)
+	s.AddTool(playoffsTool, nhlserver.PlayoffsHandler)
+	s.AddTool(draftTool, nhlserver.DraftHandler)
+	s.AddTool(leagueScheduleTool, nhlserver.LeagueScheduleHandler)
 	s.AddTool(gameTool, nhlserver.GameHandler)
 	s.AddTool(liveTool, nhlserver.LiveHandler)
 	s.AddTool(teamsTool, nhlserver.TeamsHandler)
 	s.AddTool(highlightsTool, nhlserver.HighlightsHandler)
 
+	return s
+}
+
+// newHandler serves the MCP server over streamable HTTP at /mcp and SSE at
+// /sse, with a health check and CORS headers
+func newHandler(s *server.MCPServer, baseURL string) http.Handler {
 	// Create Streamable HTTP server (newer MCP transport)
 	httpServer := server.NewStreamableHTTPServer(s,
 		server.WithEndpointPath("/mcp"),
@@ -165,8 +339,5 @@ func main() {
 		mux.ServeHTTP(w, r)
 	})
 
-	log.Printf("NHL MCP server listening on %s", addr)
-	log.Printf("Streamable HTTP endpoint: %s/mcp", baseURL)
-	log.Printf("SSE endpoint: %s/sse", baseURL)
-	log.Fatal(http.ListenAndServe(addr, corsHandler))
+	return corsHandler
 }
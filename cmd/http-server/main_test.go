@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"go-nhl/client/nhltest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	nhlserver "go-nhl/mcp/server"
+)
+
+// callTool posts a tools/call request to the /mcp endpoint and returns the
+// text of the result
+func callTool(t *testing.T, url, tool string, args map[string]any) (string, bool) {
+	t.Helper()
+
+	request, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": tool, "arguments": args},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url+"/mcp", strings.NewReader(string(request)))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /mcp error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /mcp status = %d: %s", resp.StatusCode, body)
+	}
+
+	var response struct {
+		Result struct {
+			Content []struct {
+				Text string `json:"text"`
+			} `json:"content"`
+			IsError bool `json:"isError"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		t.Fatalf("invalid response %s: %v", body, err)
+	}
+
+	var text strings.Builder
+	for _, content := range response.Result.Content {
+		text.WriteString(content.Text)
+	}
+	return text.String(), response.Result.IsError
+}
+
+func TestHTTPServer(t *testing.T) {
+	fake := nhltest.NewServer()
+	defer fake.Close()
+	nhlserver.SetClient(fake.Client())
+
+	server := httptest.NewServer(newHandler(newMCPServer(), ""))
+	defer server.Close()
+
+	tests := []struct {
+		tool string
+		args map[string]any
+		want string
+	}{
+		{tool: "nhl-slate", args: map[string]any{"date": nhltest.FixtureDate}, want: `"abbrev": "CHI"`},
+		{tool: "nhl-slate", args: map[string]any{"date": nhltest.FixtureDate, "country": "CA"}, want: `"network": "CBC"`},
+		{tool: "nhl-standings", args: map[string]any{}, want: "Rangers"},
+		{tool: "nhl-teams", args: map[string]any{}, want: "Dallas Stars"},
+		{tool: "nhl-league-schedule", args: map[string]any{"startDate": "2024-03-25"}, want: `"abbrev": "EDM"`},
+		{tool: "nhl-draft", args: map[string]any{"year": 2015}, want: "Rantanen"},
+		{tool: "nhl-playoffs", args: map[string]any{"year": 2024}, want: "Stanley Cup Final"},
+		{tool: "nhl-club-stats", args: map[string]any{"team": "NYR", "seasonID": nhltest.FixtureSeason}, want: "Zibanejad"},
+		{tool: "nhl-game", args: map[string]any{"gameId": nhltest.FixtureGameID}, want: "United Center"},
+		{tool: "nhl-highlights", args: map[string]any{"gameId": nhltest.FixtureGameID}, want: "Bedard"},
+		{tool: "nhl-highlights", args: map[string]any{"gameId": "NYR 2024-02-09"}, want: "Bedard"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tool, func(t *testing.T) {
+			text, isError := callTool(t, server.URL, tt.tool, tt.args)
+			if isError {
+				t.Fatalf("tool error: %s", text)
+			}
+			if !strings.Contains(text, tt.want) {
+				t.Errorf("result missing %q", tt.want)
+			}
+		})
+	}
+
+	resp, err := http.Get(server.URL + "/health")
+	if err != nil {
+		t.Fatalf("GET /health error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /health status = %d, want 200", resp.StatusCode)
+	}
+}
@@ -3,14 +3,20 @@ package cmd
 // todo: rename to nhl-cli ?
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	nhl "go-nhl/client"
+	"go-nhl/internal/config"
+	"go-nhl/internal/errmsg"
 	"go-nhl/mcp/server"
 	"log"
 	"time"
 )
 
+// defaultGame is the game -game shows without -game-id
+const defaultGame = "NYR@CHI 2024-02-09"
+
 type Config struct {
 	// Command flags
 	TodaysSchedule      bool
@@ -29,13 +35,58 @@ type Config struct {
 	GameDetails         bool
 	LiveUpdates         bool
 	Leaders             bool
+	GameLog             bool
+	ClubStats           bool
+	Bracket             bool
+	Draft               bool
 
 	// Parameters
 	Date           string
 	Name           string
-	GameID         int
+	GameID         string
 	UpdateInterval int
 
+	// Game log and leaders parameters
+	Season   int
+	Playoffs bool
+	From     string
+	To       string
+
+	// Leaders parameters
+	Categories string
+	Limit      int
+
+	// Club stats parameters
+	Sort        string
+	ListSeasons bool
+
+	// Playoff parameters
+	Series string
+
+	// Draft parameters
+	Year  int
+	Round int
+
+	// League schedule filters
+	HomeAway string
+	States   string
+
+	// Broadcast filters
+	Country    string
+	Network    string
+	Market     string
+	Broadcasts bool
+
+	// Play-by-play filters, with Team and Name for the team and player
+	Periods   string
+	Events    string
+	Strengths string
+
+	// Player search filters
+	ActiveOnly bool
+	Position   string
+	Team       string
+
 	// NHL Client
 	Client *nhl.Client
 }
@@ -46,11 +97,18 @@ func NewConfig() *Config {
 	}
 }
 
-func (c *Config) ParseFlags() {
+func (c *Config) ParseFlags() error {
+	// NHL client settings, defaulting to the environment
+	clientConfig, err := config.FromEnv()
+	if err != nil {
+		return err
+	}
+	clientConfig.RegisterFlags(flag.CommandLine)
+
 	// Command flags
 	flag.BoolVar(&c.TodaysSchedule, "today", false, "Get today's NHL schedule")
-	flag.BoolVar(&c.Slate, "slate", false, "Get schedule for a specific date")
-	flag.BoolVar(&c.Roster, "roster", false, "Get team rosters")
+	flag.BoolVar(&c.Slate, "slate", false, "Get the league schedule for a date, or a range given by -from and -to")
+	flag.BoolVar(&c.Roster, "roster", false, "Get team rosters, or the roster of the team given by -name")
 	flag.BoolVar(&c.PlayerSearch, "player", false, "Search for any player")
 	flag.BoolVar(&c.SkaterSearch, "skater", false, "Search for skaters with detailed stats")
 	flag.BoolVar(&c.GoalieSearch, "goalie", false, "Search for goalies with detailed stats")
@@ -64,21 +122,56 @@ func (c *Config) ParseFlags() {
 	flag.BoolVar(&c.GameDetails, "game", false, "Get detailed game information")
 	flag.BoolVar(&c.Leaders, "leaders", false, "Get NHL league leaders")
 	flag.BoolVar(&c.LiveUpdates, "live", false, "Show live game updates")
+	flag.BoolVar(&c.GameLog, "gamelog", false, "Get a player's game-by-game stats")
+	flag.BoolVar(&c.ClubStats, "club-stats", false, "Get a team's skater and goalie stats for a season")
+	flag.BoolVar(&c.Bracket, "bracket", false, "Get the playoff bracket for a season")
+	flag.BoolVar(&c.Draft, "draft", false, "Get a draft class, or where the player given by -name was drafted")
 
 	// Parameters
-	flag.IntVar(&c.GameID, "game-id", 2024020750, "Game ID for game details (default: NYR vs CHI on Feb 9, 2024)")
+	flag.StringVar(&c.GameID, "game-id", defaultGame, "Game for game details: an ID such as 2023020750 or 2023-02-0750, or teams and a date such as NYR@CHI 2024-02-09 or NYR 2024-02-09")
 	flag.IntVar(&c.UpdateInterval, "interval", 60, "Update interval in seconds for live updates")
 	flag.StringVar(&c.Date, "date", "", "Date to get schedule for (format: YYYY-MM-DD)")
-	flag.StringVar(&c.Name, "name", "", "Team name for roster, schedule, and standings")
+	flag.StringVar(&c.Name, "name", "", "Team name for roster, schedule, and standings, or a player to show the game plays of")
+	flag.IntVar(&c.Season, "season", 0, "Season ID for the game log, leaders, club stats, bracket and roster, e.g. 20232024 (default: current season)")
+	flag.BoolVar(&c.Playoffs, "playoffs", false, "Show playoff games, leaders and club stats")
+	flag.StringVar(&c.From, "from", "", "First date of the game log or slate (format: YYYY-MM-DD)")
+	flag.StringVar(&c.To, "to", "", "Last date of the game log or slate (format: YYYY-MM-DD, default for the slate: a week after -from)")
+	flag.StringVar(&c.Categories, "categories", "", "Comma separated leader categories, or skaters or goalies (default: all)")
+	flag.IntVar(&c.Limit, "limit", 0, "Players per leader category, -1 for all (default: 5)")
+	flag.StringVar(&c.Sort, "sort", "points", "Stat to sort club stats by, e.g. goals, timeOnIce or savePercentage")
+	flag.BoolVar(&c.ListSeasons, "list-seasons", false, "List the seasons a team has club stats or rosters for")
+	flag.StringVar(&c.Series, "series", "", "Playoff series letter, A to O, to show the games of with -bracket")
+	flag.IntVar(&c.Year, "year", 0, "Draft year, e.g. 2015 (default: most recent draft)")
+	flag.IntVar(&c.Round, "round", 0, "Draft round (default: all rounds)")
+	flag.StringVar(&c.HomeAway, "home-away", "", "Only show slate games where -team is home or away")
+	flag.StringVar(&c.States, "state", "", "Comma separated game states to show in the slate, e.g. FUT, LIVE or OFF")
+	flag.StringVar(&c.Country, "country", "", "Only show games broadcast in these comma separated countries, e.g. US or CA")
+	flag.StringVar(&c.Network, "network", "", "Only show games on these comma separated networks, e.g. ESPN,SN")
+	flag.StringVar(&c.Market, "market", "", "Only show games with national, home or away broadcasts (comma separated)")
+	flag.BoolVar(&c.Broadcasts, "broadcasts", false, "List the networks carrying each game of the -schedule team's season")
+	flag.BoolVar(&c.ActiveOnly, "active", false, "Only find players currently on an NHL roster")
+	flag.StringVar(&c.Position, "position", "", "Only find players at a position (C, L, R, D, G or F for any forward)")
+	flag.StringVar(&c.Team, "team", "", "Team abbreviation to find players, draft picks or game plays of, or comma separated teams to show -slate games for")
+	flag.StringVar(&c.Periods, "period", "", "Only show game plays in these comma separated periods, e.g. 1,2 or 4 for overtime")
+	flag.StringVar(&c.Events, "event", "", "Only show game plays of these comma separated types, e.g. goal,penalty or shot-on-goal")
+	flag.StringVar(&c.Strengths, "strength", "", "Only show game plays made at these comma separated strengths, e.g. PP, SH, EV, 4v4 or EN")
 
 	flag.Parse()
+
+	client, err := clientConfig.NewClient()
+	if err != nil {
+		return err
+	}
+	c.Client = client
+	return nil
 }
 
-func (c *Config) Execute() error {
+func (c *Config) Execute(ctx context.Context) error {
 	// Check for subcommands first
 	if len(flag.Args()) > 0 {
 		switch flag.Arg(0) {
 		case "mcp":
+			server.SetClient(c.Client)
 			return server.Start()
 		}
 	}
@@ -87,25 +180,31 @@ func (c *Config) Execute() error {
 
 	if c.TodaysSchedule {
 		commandsRun = true
-		if err := c.RunTodaysSchedule(); err != nil {
+		if err := c.RunTodaysSchedule(ctx); err != nil {
 			return err
 		}
 	}
 
 	if c.Slate {
 		commandsRun = true
-		slateDate := c.Date
-		if slateDate == "" {
-			slateDate = time.Now().Format("2006-01-02")
-		}
-		if err := c.RunScheduleByDate(slateDate); err != nil {
-			return err
+		if c.From != "" || c.To != "" || c.Team != "" || c.HomeAway != "" || c.States != "" || c.Playoffs {
+			if err := c.RunLeagueSchedule(ctx); err != nil {
+				return err
+			}
+		} else {
+			slateDate := c.Date
+			if slateDate == "" {
+				slateDate = time.Now().Format("2006-01-02")
+			}
+			if err := c.RunScheduleByDate(ctx, slateDate); err != nil {
+				return err
+			}
 		}
 	}
 
 	if c.Roster {
 		commandsRun = true
-		if err := c.RunTeamRoster(); err != nil {
+		if err := c.RunTeamRoster(ctx); err != nil {
 			return err
 		}
 	}
@@ -116,7 +215,7 @@ func (c *Config) Execute() error {
 		if playerName == "" {
 			playerName = "Robertson"
 		}
-		if err := c.RunPlayerSearch(playerName); err != nil {
+		if err := c.RunPlayerSearch(ctx, playerName); err != nil {
 			return err
 		}
 	}
@@ -127,7 +226,7 @@ func (c *Config) Execute() error {
 		if skaterName == "" {
 			skaterName = "Hintz"
 		}
-		if err := c.RunSkaterSearch(skaterName); err != nil {
+		if err := c.RunSkaterSearch(ctx, skaterName); err != nil {
 			return err
 		}
 	}
@@ -138,7 +237,7 @@ func (c *Config) Execute() error {
 		if goalieName == "" {
 			goalieName = "Oettinger"
 		}
-		if err := c.RunGoalieSearch(goalieName); err != nil {
+		if err := c.RunGoalieSearch(ctx, goalieName); err != nil {
 			return err
 		}
 	}
@@ -149,7 +248,7 @@ func (c *Config) Execute() error {
 		if playerName == "" {
 			playerName = "Johnston"
 		}
-		if err := c.RunSeasonStats(playerName); err != nil {
+		if err := c.RunSeasonStats(ctx, playerName); err != nil {
 			return err
 		}
 	}
@@ -160,14 +259,14 @@ func (c *Config) Execute() error {
 		if teamName == "" {
 			teamName = "DAL"
 		}
-		if err := c.RunTeamSchedule(teamName); err != nil {
+		if err := c.RunTeamSchedule(ctx, teamName); err != nil {
 			return err
 		}
 	}
 
 	if c.Standings {
 		commandsRun = true
-		if err := c.RunCurrentStandings(); err != nil {
+		if err := c.RunCurrentStandings(ctx); err != nil {
 			return err
 		}
 	}
@@ -178,42 +277,82 @@ func (c *Config) Execute() error {
 		if standingsDate == "" {
 			standingsDate = time.Now().Format("2006-01-02")
 		}
-		if err := c.RunStandingsByDate(standingsDate); err != nil {
+		if err := c.RunStandingsByDate(ctx, standingsDate); err != nil {
 			return err
 		}
 	}
 
 	if c.LeagueStandings {
 		commandsRun = true
-		if err := c.RunLeagueStandings(); err != nil {
+		if err := c.RunLeagueStandings(ctx); err != nil {
 			return err
 		}
 	}
 
 	if c.ConferenceStandings {
 		commandsRun = true
-		if err := c.RunConferenceStandings(); err != nil {
+		if err := c.RunConferenceStandings(ctx); err != nil {
 			return err
 		}
 	}
 
 	if c.DivisionStandings {
 		commandsRun = true
-		if err := c.RunDivisionStandings(); err != nil {
+		if err := c.RunDivisionStandings(ctx); err != nil {
 			return err
 		}
 	}
 
 	if c.GameDetails {
 		commandsRun = true
-		if err := c.RunGameDetails(); err != nil {
+		if err := c.RunGameDetails(ctx); err != nil {
 			return err
 		}
 	}
 
 	if c.Leaders {
 		commandsRun = true
-		if err := c.RunLeagueLeaders(); err != nil {
+		if err := c.RunLeagueLeaders(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.GameLog {
+		commandsRun = true
+		playerName := c.Name
+		if playerName == "" {
+			playerName = "Heiskanen"
+		}
+		if err := c.RunPlayerGameLog(ctx, playerName); err != nil {
+			return err
+		}
+	}
+
+	if c.ClubStats {
+		commandsRun = true
+		teamName := c.Name
+		if teamName == "" {
+			teamName = "DAL"
+		}
+		if err := c.RunClubStats(ctx, teamName); err != nil {
+			return err
+		}
+	}
+
+	if c.Bracket {
+		commandsRun = true
+		if err := c.RunPlayoffBracket(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Draft {
+		commandsRun = true
+		if c.Name != "" {
+			if err := c.RunPlayerDraft(ctx, c.Name); err != nil {
+				return err
+			}
+		} else if err := c.RunDraftClass(ctx); err != nil {
 			return err
 		}
 	}
@@ -222,10 +361,14 @@ func (c *Config) Execute() error {
 		commandsRun = true
 		fmt.Printf("Starting live game updates (refreshing every %d seconds). Press Ctrl+C to stop.\n", c.UpdateInterval)
 		for {
-			if err := c.RunLiveGameUpdates(); err != nil {
-				log.Printf("Error getting live updates: %v", err)
+			if err := c.RunLiveGameUpdates(ctx); err != nil {
+				log.Printf("Error getting live updates: %s", errmsg.Friendly(err))
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Duration(c.UpdateInterval) * time.Second):
 			}
-			time.Sleep(time.Duration(c.UpdateInterval) * time.Second)
 		}
 	}
 
@@ -239,20 +382,24 @@ func (c *Config) Execute() error {
 func (c *Config) PrintUsage() {
 	fmt.Println("Available commands (use -h flag to see all options):")
 	fmt.Println("- today: Get today's NHL schedule")
-	fmt.Println("- slate: Get schedule for a specific date")
+	fmt.Println("- slate: Get the league schedule for a date or range of dates")
 	fmt.Println("- roster: Get team rosters")
 	fmt.Println("- player: Search for any player")
 	fmt.Println("- skater: Search for skaters with detailed stats")
 	fmt.Println("- goalie: Search for goalies with detailed stats")
 	fmt.Println("- stats: Get player stats across seasons")
-	fmt.Println("- schedule: Get a team's full schedule")
+	fmt.Println("- schedule: Get a team's full schedule, or the networks carrying it with -broadcasts")
 	fmt.Println("- standings: Get current NHL standings")
 	fmt.Println("- standings-by-date: Get NHL standings for a specific date")
 	fmt.Println("- league-standings: Get overall NHL standings")
 	fmt.Println("- conference: Get standings by conference")
 	fmt.Println("- division: Get standings by division")
-	fmt.Println("- game: Get detailed game information")
+	fmt.Println("- game: Get detailed game information, with plays filtered by -team, -name, -period, -event and -strength")
 	fmt.Println("- live: Show live game updates")
 	fmt.Println("- leaders: Get NHL league leaders")
+	fmt.Println("- gamelog: Get a player's game-by-game stats")
+	fmt.Println("- club-stats: Get a team's skater and goalie stats for a season")
+	fmt.Println("- bracket: Get the playoff bracket for a season")
+	fmt.Println("- draft: Get a draft class, or where a player was drafted")
 	fmt.Println("- mcp: Start the MCP server")
 }
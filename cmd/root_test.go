@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"go-nhl/client/nhltest"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	nhl "go-nhl/client"
+)
+
+// captureOutput returns what fn prints to stdout
+func captureOutput(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		output <- string(data)
+	}()
+
+	runErr := fn()
+	w.Close()
+	return <-output, runErr
+}
+
+func TestExecute(t *testing.T) {
+	server := nhltest.NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		config Config
+		want   []string
+	}{
+		{
+			name:   "Slate",
+			config: Config{Slate: true, Date: nhltest.FixtureDate},
+			want:   []string{"Rangers at Blackhawks", "Score: Rangers 4, Blackhawks 1", "Maple Leafs at Bruins"},
+		},
+		{
+			name:   "Slate range",
+			config: Config{Slate: true, From: "2024-02-05", To: "2024-02-18", Team: "bos", HomeAway: "home"},
+			want:   []string{"Games from 2024-02-05 to 2024-02-18", "Friday, February 9", "TOR @ BOS"},
+		},
+		{
+			name:   "Slate national broadcasts",
+			config: Config{Slate: true, Date: nhltest.FixtureDate, Country: "ca", Market: "national"},
+			want:   []string{"Rangers at Blackhawks", "TV: SN (CA national)", "Maple Leafs at Bruins", "TV: CBC (CA national)"},
+		},
+		{
+			name:   "Slate range by network",
+			config: Config{Slate: true, From: "2024-02-05", To: "2024-02-11", Network: "NESN"},
+			want:   []string{"TOR @ BOS", "TV: NESN (US home)"},
+		},
+		{
+			name:   "Game details",
+			config: Config{GameDetails: true, GameID: strconv.Itoa(nhltest.FixtureGameID)},
+			want:   []string{"Panarin", "Bedard", "Faceoff %       53.4%  46.6%", "Season Series (NYR leads 1-0)", "Wes McCauley"},
+		},
+		{
+			name:   "Game by teams and date",
+			config: Config{GameDetails: true, GameID: "chi vs nyr " + nhltest.FixtureDate},
+			want:   []string{"Panarin", "Bedard"},
+		},
+		{
+			name:   "Default game",
+			config: Config{GameDetails: true},
+			want:   []string{"Panarin", "Bedard"},
+		},
+		{
+			name:   "Game plays by player and type",
+			config: Config{GameDetails: true, Name: "panarin", Events: "goal"},
+			want:   []string{"GOAL! Scored by Artemi Panarin (10) (Assists: Mika Zibanejad (93), Adam Fox (23))", "GOAL! Scored by Chris Kreider (20) (Assist: Artemi Panarin (10))"},
+		},
+		{
+			name:   "Game plays by team, period and strength",
+			config: Config{GameDetails: true, Team: "CHI", Periods: "2", Strengths: "PP"},
+			want:   []string{"2      03:40    16:20    GOAL! Scored by Connor Bedard (98)"},
+		},
+		{
+			name:   "Game turnovers",
+			config: Config{GameDetails: true, Events: "giveaway, Takeaway", Periods: "1"},
+			want:   []string{"Giveaway by Nick Foligno (17)", "Takeaway by Mika Zibanejad (93)"},
+		},
+		{
+			name:   "Standings",
+			config: Config{Standings: true},
+			want:   []string{"Rangers", "Blackhawks"},
+		},
+		{
+			name:   "Division standings",
+			config: Config{DivisionStandings: true},
+			want:   []string{"Metropolitan", "Central"},
+		},
+		{
+			name:   "Roster",
+			config: Config{Roster: true},
+			want:   []string{"Roster for DAL", "Heiskanen", "Oettinger"},
+		},
+		{
+			name:   "Player search",
+			config: Config{PlayerSearch: true, Name: "Heiskanen"},
+			want:   []string{"Heiskanen", "DAL"},
+		},
+		{
+			name:   "Fuzzy player search",
+			config: Config{PlayerSearch: true, Name: "panarn", ActiveOnly: true},
+			want:   []string{"Artemi Panarin (#10) - NYR L, born 1991"},
+		},
+		{
+			name:   "Game log",
+			config: Config{GameLog: true, Name: "Heiskanen", Season: nhltest.FixtureSeason, From: "2024-02-01"},
+			want:   []string{"Game Log for Miro Heiskanen (2023-2024 Regular Season)", "@ NYR", "vs CGY", "Totals (3 games)", "Points: 3"},
+		},
+		{
+			name:   "Goalie game log",
+			config: Config{GameLog: true, Name: "Shesterkin", Season: nhltest.FixtureSeason},
+			want:   []string{"Game Log for Igor Shesterkin", "Record: 1-1-1"},
+		},
+		{
+			name:   "Club stats",
+			config: Config{ClubStats: true, Name: "NYR", Season: nhltest.FixtureSeason, Sort: "goals"},
+			want:   []string{"Club Stats for New York Rangers (2023-2024 Regular Season)", "Artemi Panarin", "Igor Shesterkin"},
+		},
+		{
+			name:   "Club stats seasons",
+			config: Config{ClubStats: true, Name: "NYR", ListSeasons: true},
+			want:   []string{"2023-2024: Regular Season, Playoff"},
+		},
+		{
+			name:   "Playoff bracket",
+			config: Config{Bracket: true, Season: nhltest.FixtureSeason},
+			want:   []string{"2024 Stanley Cup Playoffs", "Eastern Conference", "FLA (D1)     4-1  TBL (WC1)    FLA wins 4-1", "EDM wins 4-3", "Stanley Cup Final"},
+		},
+		{
+			name:   "Playoff series",
+			config: Config{Bracket: true, Season: nhltest.FixtureSeason, Series: "a"},
+			want:   []string{"Florida Panthers vs Tampa Bay Lightning", "FLA wins 4-1", "TBL 2 @ FLA 3", "Final/OT", "If necessary"},
+		},
+		{
+			name:   "Draft class",
+			config: Config{Draft: true, Year: 2015, Team: "CAR"},
+			want:   []string{"2015 NHL Draft", "Noah Hanifin", "Sebastian Aho"},
+		},
+		{
+			name:   "Player draft",
+			config: Config{Draft: true, Name: "Heiskanen"},
+			want:   []string{"Miro Heiskanen was drafted 3rd overall by DAL in 2017 (round 1, pick 3)"},
+		},
+		{
+			name:   "Undrafted player",
+			config: Config{Draft: true, Name: "Panarin"},
+			want:   []string{"Artemi Panarin was never drafted"},
+		},
+		{
+			name:   "Roster for a season",
+			config: Config{Roster: true, Name: "Boston Bruins", Season: 20102011},
+			want:   []string{"Roster for BOS (2010-2011)", "#37 Patrice Bergeron - C", "#30 Tim Thomas - G"},
+		},
+		{
+			name:   "Roster for a relocated franchise",
+			config: Config{Roster: true, Name: "atl", Season: 19992000},
+			want:   []string{"Roster for ATL (1999-2000)", "#13 Patrik Stefan - C", "#1 Damian Rhodes - G"},
+		},
+		{
+			name:   "Relocated franchise roster seasons",
+			config: Config{Roster: true, Name: "ATL", ListSeasons: true},
+			want:   []string{"Seasons with rosters for ATL", "1999-2000"},
+		},
+		{
+			name:   "Roster seasons",
+			config: Config{Roster: true, Name: "DAL", ListSeasons: true},
+			want:   []string{"Seasons with rosters for Dallas Stars", "2023-2024"},
+		},
+		{
+			name:   "Team schedule",
+			config: Config{Schedule: true, Name: "CHI"},
+			want:   []string{"NYR", "DAL"},
+		},
+		{
+			name:   "Team TV schedule",
+			config: Config{Schedule: true, Name: "NYR", Broadcasts: true},
+			want:   []string{"TV schedule for New York Rangers", "vs CHI  MSG (US home)", "@ CHI", "MSGSN (US away), NBCSCH (US home), SN (CA national)"},
+		},
+		{
+			name:   "Team national broadcasts",
+			config: Config{Schedule: true, Name: "NYR", Market: "N", Country: "US"},
+			want:   []string{"TV schedule for New York Rangers", "@ BOS", "ESPN (US national)"},
+		},
+		{
+			name:   "Leaders",
+			config: Config{Leaders: true},
+			want:   []string{"Panarin", "Shesterkin"},
+		},
+		{
+			name:   "Goalie leaders",
+			config: Config{Leaders: true, Categories: "savePctg,shutouts", Limit: 1, Playoffs: true},
+			want:   []string{"Playoffs", "Save Percentage Leaders", "Hellebuyck", "0.921"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			config.Client = server.Client()
+
+			output, err := captureOutput(t, func() error {
+				return config.Execute(context.Background())
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("Execute() output missing %q:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteWithoutRightRail(t *testing.T) {
+	server := nhltest.NewServer()
+	defer server.Close()
+	server.Fail("gamecenter/"+strconv.Itoa(nhltest.FixtureGameID)+"/right-rail", http.StatusNotFound, 0)
+
+	config := Config{GameDetails: true, GameID: strconv.Itoa(nhltest.FixtureGameID), Client: server.Client()}
+	output, err := captureOutput(t, func() error {
+		return config.Execute(context.Background())
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(output, "Panarin") || strings.Contains(output, "Season Series") {
+		t.Errorf("Execute() output without the right rail:\n%s", output)
+	}
+}
+
+func TestExecuteTeamNotInGame(t *testing.T) {
+	server := nhltest.NewServer()
+	defer server.Close()
+
+	config := Config{GameDetails: true, GameID: strconv.Itoa(nhltest.FixtureGameID), Team: "BOS", Client: server.Client()}
+	_, err := captureOutput(t, func() error {
+		return config.Execute(context.Background())
+	})
+	if err == nil || !strings.Contains(err.Error(), `team "BOS" did not play`) {
+		t.Errorf("Execute() error = %v, want team not in the game", err)
+	}
+}
+
+func TestExecuteTeamListOutsideSlate(t *testing.T) {
+	server := nhltest.NewServer()
+	defer server.Close()
+
+	for name, config := range map[string]Config{
+		"Draft class":   {Draft: true, Year: 2015, Team: "CAR,NYR"},
+		"Player search": {PlayerSearch: true, Name: "Heiskanen", Team: "DAL,NYR"},
+		"Game plays":    {GameDetails: true, Team: "NYR,CHI"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config.Client = server.Client()
+			_, err := captureOutput(t, func() error {
+				return config.Execute(context.Background())
+			})
+			if err == nil || !strings.Contains(err.Error(), "only for -slate") {
+				t.Errorf("Execute() error = %v, want a team list error", err)
+			}
+		})
+	}
+}
+
+func TestExecuteAPIError(t *testing.T) {
+	server := nhltest.NewServer()
+	defer server.Close()
+	server.Fail("gamecenter", http.StatusNotFound, 0)
+
+	config := Config{GameDetails: true, GameID: strconv.Itoa(nhltest.FixtureGameID), Client: server.Client()}
+	_, err := captureOutput(t, func() error {
+		return config.Execute(context.Background())
+	})
+	if !errors.Is(err, nhl.ErrNotFound) {
+		t.Errorf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
@@ -1,54 +1,58 @@
 package examples
 
 import (
+	"context"
 	"fmt"
 
-	"go-nhl/internal/display"
 	"go-nhl/client"
+	"go-nhl/internal/display"
 )
 
 // GetGameDetails demonstrates retrieving and displaying detailed game information
-func GetGameDetails(client *nhl.Client, gameID int) error {
+func GetGameDetails(ctx context.Context, client *nhl.Client, gameID nhl.GameID) error {
 	// Get basic game details
-	details, err := client.GetGameDetails(gameID)
+	details, err := client.GetGameDetails(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("error getting game details: %v", err)
+		return fmt.Errorf("error getting game details: %w", err)
 	}
 	if details == nil {
 		return fmt.Errorf("no game details found for ID: %d", gameID)
 	}
 
 	// Get boxscore
-	boxscore, err := client.GetGameBoxscore(gameID)
+	boxscore, err := client.GetGameBoxscore(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("error getting game boxscore: %v", err)
+		return fmt.Errorf("error getting game boxscore: %w", err)
 	}
 	if boxscore == nil {
 		return fmt.Errorf("no boxscore found for ID: %d", gameID)
 	}
 
+	// Get the right rail, which only adds to the details
+	rail, _ := client.GetGameRightRail(ctx, gameID)
+
 	// Display game details with boxscore
-	display.GameDetails(details, boxscore)
+	display.GameDetails(details, boxscore, rail)
 
 	// Display boxscore
 	display.GameBoxscore(boxscore)
 
 	// Get play-by-play
-	pbp, err := client.GetGamePlayByPlay(gameID)
+	pbp, err := client.GetGamePlayByPlay(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("error getting play-by-play: %v", err)
+		return fmt.Errorf("error getting play-by-play: %w", err)
 	}
 	if pbp == nil {
 		return fmt.Errorf("no play-by-play found for ID: %d", gameID)
 	}
-	display.GamePlayByPlay(pbp)
+	display.GamePlayByPlay(pbp, nil)
 
 	return nil
 }
 
 // GetLiveGameUpdates demonstrates retrieving live game updates
-func GetLiveGameUpdates(client *nhl.Client) error {
-	updates, err := client.GetLiveGameUpdates()
+func GetLiveGameUpdates(ctx context.Context, client *nhl.Client) error {
+	updates, err := client.GetLiveGameUpdates(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get live game updates: %w", err)
 	}
@@ -1,25 +1,26 @@
 package examples
 
 import (
+	"context"
 	"fmt"
-	"go-nhl/internal/display"
 	"go-nhl/client"
+	"go-nhl/internal/display"
 )
 
 // GetLeagueLeaders demonstrates retrieving and displaying NHL stats leaders
-func GetLeagueLeaders(client *nhl.Client) error {
+func GetLeagueLeaders(ctx context.Context, client *nhl.Client) error {
 	// Get current season leaders
-	leaders, err := client.GetStatsLeaders(0)
+	leaders, err := client.GetStatsLeaders(ctx, 0)
 	if err != nil {
-		return fmt.Errorf("error getting stats leaders: %v", err)
+		return fmt.Errorf("error getting stats leaders: %w", err)
 	}
 
 	display.StatsLeaders(leaders, 0)
 
 	// Get previous season leaders
-	prevSeasonLeaders, err := client.GetStatsLeaders(20222023)
+	prevSeasonLeaders, err := client.GetStatsLeaders(ctx, 20222023)
 	if err != nil {
-		return fmt.Errorf("error getting previous season stats leaders: %v", err)
+		return fmt.Errorf("error getting previous season stats leaders: %w", err)
 	}
 
 	display.StatsLeaders(prevSeasonLeaders, 20222023)
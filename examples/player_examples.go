@@ -1,16 +1,17 @@
 package examples
 
 import (
+	"context"
 	"fmt"
-	"go-nhl/internal/display"
 	"go-nhl/client"
+	"go-nhl/internal/display"
 )
 
 // SearchPlayer demonstrates searching for players and displaying their basic info
-func SearchPlayer(client *nhl.Client, searchName string) error {
-	players, err := client.SearchPlayer(searchName)
+func SearchPlayer(ctx context.Context, client *nhl.Client, searchName string) error {
+	players, err := client.SearchPlayer(ctx, searchName)
 	if err != nil {
-		return fmt.Errorf("error searching for player %s: %v", searchName, err)
+		return fmt.Errorf("error searching for player %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -31,9 +32,9 @@ func SearchPlayer(client *nhl.Client, searchName string) error {
 
 	// Get stats for the first player found
 	player := players[0]
-	stats, err := client.GetFilteredPlayerStats(player.PlayerID, nil)
+	stats, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, nil)
 	if err != nil {
-		return fmt.Errorf("error getting stats for player %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting stats for player %d: %w", player.PlayerID, err)
 	}
 
 	display.SeasonStats(stats, nhl.GameTypeRegularSeason)
@@ -41,10 +42,10 @@ func SearchPlayer(client *nhl.Client, searchName string) error {
 }
 
 // SearchSkater demonstrates searching for skaters and displaying their stats
-func SearchSkater(client *nhl.Client, searchName string) error {
-	players, err := client.SearchPlayer(searchName)
+func SearchSkater(ctx context.Context, client *nhl.Client, searchName string) error {
+	players, err := client.SearchPlayer(ctx, searchName)
 	if err != nil {
-		return fmt.Errorf("error searching for skater %s: %v", searchName, err)
+		return fmt.Errorf("error searching for skater %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -55,7 +56,7 @@ func SearchSkater(client *nhl.Client, searchName string) error {
 	// Filter for skaters only
 	var skaters []nhl.PlayerSearchResult
 	for _, player := range players {
-		if player.Position != "G" {
+		if !player.Position.IsGoalie() {
 			skaters = append(skaters, player)
 		}
 	}
@@ -81,21 +82,21 @@ func SearchSkater(client *nhl.Client, searchName string) error {
 
 	// Get regular season stats
 	fmt.Println("\nRegular Season Stats:")
-	stats, err := client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypeRegularSeason,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting regular season stats for skater %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting regular season stats for skater %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypeRegularSeason)
 
 	// Get playoff stats
 	fmt.Println("\nPlayoff Stats:")
-	stats, err = client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err = client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypePlayoffs,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting playoff stats for skater %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting playoff stats for skater %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypePlayoffs)
 	return nil
@@ -103,10 +104,10 @@ func SearchSkater(client *nhl.Client, searchName string) error {
 
 // TODO: showing player stats for goalie. Needs to be goalie stats
 // SearchGoalie demonstrates searching for goalies and displaying their stats
-func SearchGoalie(client *nhl.Client, searchName string) error {
-	players, err := client.SearchPlayer(searchName)
+func SearchGoalie(ctx context.Context, client *nhl.Client, searchName string) error {
+	players, err := client.SearchPlayer(ctx, searchName)
 	if err != nil {
-		return fmt.Errorf("error searching for goalie %s: %v", searchName, err)
+		return fmt.Errorf("error searching for goalie %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -117,7 +118,7 @@ func SearchGoalie(client *nhl.Client, searchName string) error {
 	// Filter for goalies only
 	var goalies []nhl.PlayerSearchResult
 	for _, player := range players {
-		if player.Position == "G" {
+		if player.Position.IsGoalie() {
 			goalies = append(goalies, player)
 		}
 	}
@@ -143,21 +144,21 @@ func SearchGoalie(client *nhl.Client, searchName string) error {
 
 	// Get regular season stats
 	fmt.Println("\nRegular Season Stats:")
-	stats, err := client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypeRegularSeason,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting regular season stats for goalie %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting regular season stats for goalie %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypeRegularSeason)
 
 	// Get playoff stats
 	fmt.Println("\nPlayoff Stats:")
-	stats, err = client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	stats, err = client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypePlayoffs,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting playoff stats for goalie %d: %v", player.PlayerID, err)
+		return fmt.Errorf("error getting playoff stats for goalie %d: %w", player.PlayerID, err)
 	}
 	display.SeasonStats(stats, nhl.GameTypePlayoffs)
 	return nil
@@ -1,14 +1,15 @@
 package examples
 
 import (
+	"context"
 	"fmt"
-	"go-nhl/internal/display"
 	"go-nhl/client"
+	"go-nhl/internal/display"
 	"strings"
 )
 
 // GetTeamRoster demonstrates retrieving and displaying a team's roster
-func GetTeamRoster(client *nhl.Client) error {
+func GetTeamRoster(ctx context.Context, client *nhl.Client) error {
 	// Example: Get roster for teams using different identifier types
 	identifiers := []string{
 		"DAL",                // by abbreviation
@@ -16,7 +17,7 @@ func GetTeamRoster(client *nhl.Client) error {
 		"6",                  // by ID (Boston Bruins)
 	}
 	for _, identifier := range identifiers {
-		roster, err := client.GetTeamRoster(identifier)
+		roster, err := client.GetTeamRoster(ctx, identifier)
 		if err != nil {
 			fmt.Printf("Error getting roster for %s: %v\n", identifier, err)
 			continue
@@ -1,19 +1,20 @@
 package examples
 
 import (
+	"context"
 	"fmt"
+	"go-nhl/client"
 	"go-nhl/internal/display"
 	"go-nhl/internal/formatters"
-	"go-nhl/client"
 	"time"
 )
 
 // GetTodaysSchedule demonstrates retrieving and displaying the current day's schedule
-func GetTodaysSchedule(client *nhl.Client) error {
+func GetTodaysSchedule(ctx context.Context, client *nhl.Client) error {
 	// Get today's schedule with default sort (ascending - earliest games first)
-	scores, err := client.GetCurrentSchedule()
+	scores, err := client.GetCurrentSchedule(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting current schedule: %v", err)
+		return fmt.Errorf("error getting current schedule: %w", err)
 	}
 	fmt.Println("Games sorted by start time (earliest first - default):")
 	display.Games(scores)
@@ -21,11 +22,11 @@ func GetTodaysSchedule(client *nhl.Client) error {
 }
 
 // GetScheduleByDate demonstrates retrieving and displaying a schedule for a specific date
-func GetScheduleByDate(client *nhl.Client, date string) error {
+func GetScheduleByDate(ctx context.Context, client *nhl.Client, date string) error {
 	// Example: Get schedule for a specific date with explicit descending sort
-	scores, err := client.GetScheduleByDate(date, nhl.SortByDateDesc)
+	scores, err := client.GetScheduleByDate(ctx, date, nhl.SortByDateDesc)
 	if err != nil {
-		return fmt.Errorf("error getting schedule for date %s: %v", date, err)
+		return fmt.Errorf("error getting schedule for date %s: %w", date, err)
 	}
 	fmt.Println("\nGames sorted by start time (latest first):")
 	display.Games(scores)
@@ -33,16 +34,16 @@ func GetScheduleByDate(client *nhl.Client, date string) error {
 }
 
 // GetTeamSchedule demonstrates retrieving and displaying a team's schedule
-func GetTeamSchedule(client *nhl.Client, teamIdentifier string) error {
-	team, err := client.GetTeamByIdentifier(teamIdentifier)
+func GetTeamSchedule(ctx context.Context, client *nhl.Client, teamIdentifier string) error {
+	team, err := client.GetTeamByIdentifier(ctx, teamIdentifier)
 	if err != nil {
-		return fmt.Errorf("failed to get team: %v", err)
+		return fmt.Errorf("failed to get team: %w", err)
 	}
 
 	seasonID := formatters.GetCurrentSeasonID()
-	schedule, err := client.GetTeamSchedule(team, seasonID)
+	schedule, err := client.GetTeamSchedule(ctx, team, seasonID)
 	if err != nil {
-		return fmt.Errorf("failed to get schedule: %v", err)
+		return fmt.Errorf("failed to get schedule: %w", err)
 	}
 
 	// Print schedule
@@ -1,17 +1,18 @@
 package examples
 
 import (
+	"context"
 	"fmt"
+	"go-nhl/client"
 	"go-nhl/internal/display"
 	"go-nhl/internal/formatters"
-	"go-nhl/client"
 )
 
 // GetSeasonStats demonstrates retrieving and displaying season stats for a player
-func GetSeasonStats(client *nhl.Client, searchName string) error {
-	players, err := client.SearchPlayer(searchName)
+func GetSeasonStats(ctx context.Context, client *nhl.Client, searchName string) error {
+	players, err := client.SearchPlayer(ctx, searchName)
 	if err != nil {
-		return fmt.Errorf("error searching for player %s: %v", searchName, err)
+		return fmt.Errorf("error searching for player %s: %w", searchName, err)
 	}
 
 	if len(players) == 0 {
@@ -26,9 +27,9 @@ func GetSeasonStats(client *nhl.Client, searchName string) error {
 		player.LastName.Default)
 
 	// Get all seasons to show what's available
-	allStats, err := client.GetFilteredPlayerStats(player.PlayerID, nil)
+	allStats, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, nil)
 	if err != nil {
-		return fmt.Errorf("error getting player stats: %v", err)
+		return fmt.Errorf("error getting player stats: %w", err)
 	}
 
 	fmt.Println("\nAvailable NHL Seasons:")
@@ -36,7 +37,7 @@ func GetSeasonStats(client *nhl.Client, searchName string) error {
 		fmt.Printf("- %d-%d (%s): %d games played, %d goals, %d points\n",
 			season.Season/10000,
 			(season.Season/10000)+1,
-			display.GetGameTypeName(nhl.GameType(season.GameTypeID)),
+			display.GetGameTypeName(season.GameTypeID),
 			season.GamesPlayed,
 			season.Goals,
 			season.Points)
@@ -63,7 +64,7 @@ func GetSeasonStats(client *nhl.Client, searchName string) error {
 			(s.seasonID/10000)+1,
 			display.GetGameTypeName(s.gameType))
 
-		stats, err := client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+		stats, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 			GameType: s.gameType,
 			SeasonID: s.seasonID,
 		})
@@ -82,11 +83,11 @@ func GetSeasonStats(client *nhl.Client, searchName string) error {
 
 	// Example: Compare specific stat across seasons
 	fmt.Printf("\nGoals per season (Regular Season):\n")
-	regularSeasonStats, err := client.GetFilteredPlayerStats(player.PlayerID, &nhl.StatsFilter{
+	regularSeasonStats, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, &nhl.StatsFilter{
 		GameType: nhl.GameTypeRegularSeason,
 	})
 	if err != nil {
-		return fmt.Errorf("error getting regular season stats: %v", err)
+		return fmt.Errorf("error getting regular season stats: %w", err)
 	}
 
 	for _, season := range regularSeasonStats {
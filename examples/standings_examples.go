@@ -1,18 +1,19 @@
 package examples
 
 import (
+	"context"
 	"fmt"
-	"go-nhl/internal/display"
 	"go-nhl/client"
+	"go-nhl/internal/display"
 	"sort"
 	"strings"
 )
 
 // GetCurrentStandings demonstrates retrieving and displaying current NHL standings
-func GetCurrentStandings(client *nhl.Client) error {
-	standings, err := client.GetStandings()
+func GetCurrentStandings(ctx context.Context, client *nhl.Client) error {
+	standings, err := client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting current standings: %v", err)
+		return fmt.Errorf("error getting current standings: %w", err)
 	}
 
 	fmt.Println("\nCurrent NHL Standings:")
@@ -21,10 +22,10 @@ func GetCurrentStandings(client *nhl.Client) error {
 }
 
 // GetStandingsByDate demonstrates retrieving and displaying NHL standings for a specific date
-func GetStandingsByDate(client *nhl.Client, date string) error {
-	standings, err := client.GetStandingsByDate(date)
+func GetStandingsByDate(ctx context.Context, client *nhl.Client, date string) error {
+	standings, err := client.GetStandingsByDate(ctx, date)
 	if err != nil {
-		return fmt.Errorf("error getting standings for date %s: %v", date, err)
+		return fmt.Errorf("error getting standings for date %s: %w", date, err)
 	}
 
 	fmt.Printf("\nNHL Standings for %s:\n", date)
@@ -33,10 +34,10 @@ func GetStandingsByDate(client *nhl.Client, date string) error {
 }
 
 // GetLeagueStandings demonstrates retrieving and displaying overall NHL standings
-func GetLeagueStandings(client *nhl.Client) error {
-	standings, err := client.GetStandings()
+func GetLeagueStandings(ctx context.Context, client *nhl.Client) error {
+	standings, err := client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting standings: %v", err)
+		return fmt.Errorf("error getting standings: %w", err)
 	}
 
 	// Sort all teams by points, regulation wins, goal differential
@@ -79,10 +80,10 @@ func GetLeagueStandings(client *nhl.Client) error {
 }
 
 // GetConferenceStandings demonstrates retrieving and displaying standings by conference
-func GetConferenceStandings(client *nhl.Client) error {
-	standings, err := client.GetStandings()
+func GetConferenceStandings(ctx context.Context, client *nhl.Client) error {
+	standings, err := client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting standings: %v", err)
+		return fmt.Errorf("error getting standings: %w", err)
 	}
 
 	// Group teams by conference
@@ -141,10 +142,10 @@ func GetConferenceStandings(client *nhl.Client) error {
 }
 
 // GetDivisionStandings demonstrates retrieving and displaying standings by division
-func GetDivisionStandings(client *nhl.Client) error {
-	standings, err := client.GetStandings()
+func GetDivisionStandings(ctx context.Context, client *nhl.Client) error {
+	standings, err := client.GetStandings(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting standings: %v", err)
+		return fmt.Errorf("error getting standings: %w", err)
 	}
 
 	// Group teams by division
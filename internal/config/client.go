@@ -0,0 +1,281 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	nhl "go-nhl/client"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Environment variables read by FromEnv
+const (
+	EnvBaseURL     = "NHL_BASE_URL"
+	EnvForgeURL    = "NHL_FORGE_URL"
+	EnvStatsURL    = "NHL_STATS_URL"
+	EnvSearchURL   = "NHL_SEARCH_URL"
+	EnvTimeout     = "NHL_HTTP_TIMEOUT"
+	EnvUserAgent   = "NHL_USER_AGENT"
+	EnvHeaders     = "NHL_HEADERS" // semicolon separated "Key: Value" pairs
+	EnvMaxAttempts = "NHL_MAX_ATTEMPTS"
+	EnvRateLimit   = "NHL_RATE_LIMIT" // requests per second for each upstream host
+	EnvRateBurst   = "NHL_RATE_BURST"
+	EnvCache       = "NHL_CACHE" // "memory", "disk" or empty for no cache
+	EnvCacheDir    = "NHL_CACHE_DIR"
+	EnvRecord      = "NHL_RECORD" // directory to record responses into
+	EnvOffline     = "NHL_OFFLINE"
+	EnvFixtures    = "NHL_FIXTURES"
+)
+
+// Cache modes accepted by the -cache flag
+const (
+	CacheNone   = ""
+	CacheMemory = "memory"
+	CacheDisk   = "disk"
+)
+
+// MemoryCacheEntries is the size of the in-memory response cache
+const MemoryCacheEntries = 1000
+
+// Client holds the settings used to build an NHL API client
+type Client struct {
+	BaseURL   string
+	ForgeURL  string
+	StatsURL  string
+	SearchURL string
+	Timeout   time.Duration
+	UserAgent string
+	Headers   Headers
+
+	// MaxAttempts overrides the retry policy's attempt limit when positive
+	MaxAttempts int
+
+	// RateLimit limits requests per second to each upstream host when positive
+	RateLimit float64
+	RateBurst int
+
+	// Cache selects the response cache, CacheDir is used by the disk cache
+	Cache    string
+	CacheDir string
+
+	// Record saves every response into the directory when set. Offline
+	// replays responses from Fixtures instead of using the network, so a
+	// recording replays with Fixtures set to its Record directory.
+	Record   string
+	Offline  bool
+	Fixtures string
+}
+
+// FromEnv returns client settings populated from the environment
+func FromEnv() (*Client, error) {
+	c := &Client{
+		BaseURL:   os.Getenv(EnvBaseURL),
+		ForgeURL:  os.Getenv(EnvForgeURL),
+		StatsURL:  os.Getenv(EnvStatsURL),
+		SearchURL: os.Getenv(EnvSearchURL),
+		Timeout:   nhl.DefaultTimeout,
+		UserAgent: os.Getenv(EnvUserAgent),
+		Cache:     os.Getenv(EnvCache),
+		CacheDir:  os.Getenv(EnvCacheDir),
+		Record:    os.Getenv(EnvRecord),
+		Fixtures:  os.Getenv(EnvFixtures),
+	}
+
+	if c.CacheDir == "" {
+		c.CacheDir = defaultCacheDir()
+	}
+	if c.Fixtures == "" && c.CacheDir != "" {
+		c.Fixtures = filepath.Join(c.CacheDir, "fixtures")
+	}
+
+	if offline := os.Getenv(EnvOffline); offline != "" {
+		b, err := strconv.ParseBool(offline)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvOffline, err)
+		}
+		c.Offline = b
+	}
+
+	if timeout := os.Getenv(EnvTimeout); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvTimeout, err)
+		}
+		c.Timeout = d
+	}
+
+	if maxAttempts := os.Getenv(EnvMaxAttempts); maxAttempts != "" {
+		n, err := strconv.Atoi(maxAttempts)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvMaxAttempts, err)
+		}
+		c.MaxAttempts = n
+	}
+
+	if rateLimit := os.Getenv(EnvRateLimit); rateLimit != "" {
+		rate, err := strconv.ParseFloat(rateLimit, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvRateLimit, err)
+		}
+		c.RateLimit = rate
+	}
+
+	if rateBurst := os.Getenv(EnvRateBurst); rateBurst != "" {
+		burst, err := strconv.Atoi(rateBurst)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvRateBurst, err)
+		}
+		c.RateBurst = burst
+	}
+
+	if headers := os.Getenv(EnvHeaders); headers != "" {
+		for _, header := range strings.Split(headers, ";") {
+			if strings.TrimSpace(header) == "" {
+				continue
+			}
+			if err := c.Headers.Set(header); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", EnvHeaders, err)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// RegisterFlags registers command line flags for the settings, using the
+// current values as defaults
+func (c *Client) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.BaseURL, "base-url", c.BaseURL, "Base URL of the NHL web API (env "+EnvBaseURL+")")
+	fs.StringVar(&c.ForgeURL, "forge-url", c.ForgeURL, "Base URL of the NHL content API (env "+EnvForgeURL+")")
+	fs.StringVar(&c.StatsURL, "stats-url", c.StatsURL, "Base URL of the NHL stats REST API (env "+EnvStatsURL+")")
+	fs.StringVar(&c.SearchURL, "search-url", c.SearchURL, "Base URL of the NHL player search API (env "+EnvSearchURL+")")
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, "Timeout for each NHL API request (env "+EnvTimeout+")")
+	fs.StringVar(&c.UserAgent, "user-agent", c.UserAgent, "User-Agent sent to the NHL API (env "+EnvUserAgent+")")
+	fs.IntVar(&c.MaxAttempts, "max-attempts", c.MaxAttempts, "Maximum attempts for each NHL API request, 1 disables retries (env "+EnvMaxAttempts+")")
+	fs.Float64Var(&c.RateLimit, "rate-limit", c.RateLimit, "Maximum requests per second to each NHL API host, 0 for no limit (env "+EnvRateLimit+")")
+	fs.IntVar(&c.RateBurst, "rate-burst", c.RateBurst, "Burst size for the rate limit (env "+EnvRateBurst+")")
+	fs.StringVar(&c.Cache, "cache", c.Cache, "Response cache: memory, disk or empty for none (env "+EnvCache+")")
+	fs.StringVar(&c.CacheDir, "cache-dir", c.CacheDir, "Directory for the disk response cache (env "+EnvCacheDir+")")
+	fs.StringVar(&c.Record, "record", c.Record, "Record every NHL API response into this directory, replayed with -offline -fixtures DIR (env "+EnvRecord+")")
+	fs.BoolVar(&c.Offline, "offline", c.Offline, "Replay recorded responses from the -fixtures directory instead of using the network, pass -fixtures to replay a -record directory (env "+EnvOffline+")")
+	fs.StringVar(&c.Fixtures, "fixtures", c.Fixtures, "Fixture directory used by -offline (env "+EnvFixtures+")")
+	fs.Var(&c.Headers, "header", "Extra header sent to the NHL API as \"Key: Value\", may be repeated (env "+EnvHeaders+")")
+}
+
+// Options returns the NHL client options for the settings
+func (c *Client) Options() ([]nhl.Option, error) {
+	opts := []nhl.Option{nhl.WithTimeout(c.Timeout)}
+	if c.BaseURL != "" {
+		opts = append(opts, nhl.WithBaseURL(c.BaseURL))
+	}
+	if c.ForgeURL != "" {
+		opts = append(opts, nhl.WithForgeBaseURL(c.ForgeURL))
+	}
+	if c.StatsURL != "" {
+		opts = append(opts, nhl.WithStatsBaseURL(c.StatsURL))
+	}
+	if c.SearchURL != "" {
+		opts = append(opts, nhl.WithSearchBaseURL(c.SearchURL))
+	}
+	if c.UserAgent != "" {
+		opts = append(opts, nhl.WithUserAgent(c.UserAgent))
+	}
+	for _, header := range c.Headers {
+		opts = append(opts, nhl.WithHeader(header.Key, header.Value))
+	}
+	if c.MaxAttempts > 0 {
+		policy := nhl.DefaultRetryPolicy()
+		policy.MaxAttempts = c.MaxAttempts
+		opts = append(opts, nhl.WithRetryPolicy(policy))
+	}
+	if c.RateLimit > 0 {
+		opts = append(opts, nhl.WithDefaultRateLimit(nhl.RateLimit{Rate: c.RateLimit, Burst: c.RateBurst}))
+	}
+
+	switch c.Cache {
+	case CacheNone:
+	case CacheMemory:
+		opts = append(opts, nhl.WithCache(nhl.NewMemoryCache(MemoryCacheEntries)))
+	case CacheDisk:
+		if c.CacheDir == "" {
+			return nil, fmt.Errorf("disk cache needs a cache directory")
+		}
+		cache, err := nhl.NewDiskCache(c.CacheDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open disk cache: %w", err)
+		}
+		opts = append(opts, nhl.WithCache(cache))
+	default:
+		return nil, fmt.Errorf("unknown cache %q, want %q or %q", c.Cache, CacheMemory, CacheDisk)
+	}
+
+	switch {
+	case c.Offline && c.Record != "":
+		return nil, fmt.Errorf("cannot record while offline")
+	case c.Offline:
+		if c.Fixtures == "" {
+			return nil, fmt.Errorf("offline mode needs a fixture directory")
+		}
+		// Missing recordings will not appear by retrying
+		opts = append(opts, nhl.WithHTTPClient(nhl.NewReplayer(c.Fixtures)), nhl.WithRetryPolicy(nhl.NoRetry))
+	case c.Record != "":
+		recorder, err := nhl.NewRecorder(c.Record, nil)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, nhl.WithHTTPClient(recorder))
+	}
+
+	return opts, nil
+}
+
+// NewClient builds an NHL API client from the settings, applying any
+// extra options after the configured ones
+func (c *Client) NewClient(extra ...nhl.Option) (*nhl.Client, error) {
+	opts, err := c.Options()
+	if err != nil {
+		return nil, err
+	}
+	return nhl.NewClient(append(opts, extra...)...), nil
+}
+
+// defaultCacheDir returns the per-user directory for the disk cache
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "go-nhl")
+}
+
+// Header is a single extra request header
+type Header struct {
+	Key   string
+	Value string
+}
+
+// Headers is a list of request headers usable as a repeatable flag
+type Headers []Header
+
+// String implements flag.Value
+func (h *Headers) String() string {
+	pairs := make([]string, len(*h))
+	for i, header := range *h {
+		pairs[i] = header.Key + ": " + header.Value
+	}
+	return strings.Join(pairs, "; ")
+}
+
+// Set implements flag.Value, parsing a "Key: Value" pair
+func (h *Headers) Set(value string) error {
+	key, val, ok := strings.Cut(value, ":")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return fmt.Errorf("header must be in \"Key: Value\" format: %q", value)
+	}
+	*h = append(*h, Header{Key: key, Value: strings.TrimSpace(val)})
+	return nil
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"flag"
+	"testing"
+	"time"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(EnvBaseURL, "http://localhost:8080/v1")
+	t.Setenv(EnvStatsURL, "http://localhost:8080/stats")
+	t.Setenv(EnvTimeout, "5s")
+	t.Setenv(EnvUserAgent, "nhl-test")
+	t.Setenv(EnvHeaders, "X-Api-Key: secret; X-Trace: on")
+
+	c, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error = %v", err)
+	}
+	if c.BaseURL != "http://localhost:8080/v1" {
+		t.Errorf("BaseURL = %v, want %v", c.BaseURL, "http://localhost:8080/v1")
+	}
+	if c.StatsURL != "http://localhost:8080/stats" {
+		t.Errorf("StatsURL = %v, want %v", c.StatsURL, "http://localhost:8080/stats")
+	}
+	if c.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want %v", c.Timeout, 5*time.Second)
+	}
+	if c.UserAgent != "nhl-test" {
+		t.Errorf("UserAgent = %v, want %v", c.UserAgent, "nhl-test")
+	}
+	if len(c.Headers) != 2 || c.Headers[1] != (Header{Key: "X-Trace", Value: "on"}) {
+		t.Errorf("Headers = %v, want 2 headers ending with X-Trace: on", c.Headers)
+	}
+}
+
+func TestFromEnvInvalidTimeout(t *testing.T) {
+	t.Setenv(EnvTimeout, "soon")
+
+	if _, err := FromEnv(); err == nil {
+		t.Error("FromEnv() with invalid timeout should return error")
+	}
+}
+
+func TestRegisterFlags(t *testing.T) {
+	t.Setenv(EnvBaseURL, "http://env.example.com")
+
+	c, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error = %v", err)
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	c.RegisterFlags(fs)
+	err = fs.Parse([]string{
+		"-base-url", "http://flag.example.com",
+		"-header", "X-One: 1",
+		"-header", "X-Two: 2",
+	})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if c.BaseURL != "http://flag.example.com" {
+		t.Errorf("BaseURL = %v, want flag value", c.BaseURL)
+	}
+	if len(c.Headers) != 2 {
+		t.Errorf("Headers = %v, want 2 headers", c.Headers)
+	}
+	if err := c.Headers.Set("no-colon"); err == nil {
+		t.Error("Headers.Set() without colon should return error")
+	}
+}
+
+func TestCacheOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		cache   string
+		dir     string
+		wantErr bool
+	}{
+		{name: "No cache", cache: CacheNone},
+		{name: "Memory cache", cache: CacheMemory},
+		{name: "Disk cache", cache: CacheDisk, dir: t.TempDir()},
+		{name: "Disk cache without directory", cache: CacheDisk, wantErr: true},
+		{name: "Unknown cache", cache: "redis", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{Timeout: time.Second, Cache: tt.cache, CacheDir: tt.dir}
+			_, err := c.NewClient()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecordOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  Client
+		wantErr bool
+	}{
+		{name: "Record", client: Client{Record: t.TempDir()}},
+		{name: "Offline", client: Client{Offline: true, Fixtures: t.TempDir()}},
+		{name: "Offline without fixtures", client: Client{Offline: true}, wantErr: true},
+		{name: "Record while offline", client: Client{Offline: true, Record: t.TempDir(), Fixtures: t.TempDir()}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.client.NewClient()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package display
+
+import (
+	"fmt"
+	"go-nhl/client"
+	"go-nhl/internal/formatters"
+	"strconv"
+	"strings"
+)
+
+// ClubStats displays a team's skater and goalie stats for a season
+func ClubStats(stats *nhl.ClubStats, teamName string) {
+	seasonID, _ := strconv.Atoi(stats.Season)
+	fmt.Printf("\nClub Stats for %s (%s %s)\n", teamName, formatters.FormatSeasonID(seasonID), GetGameTypeName(stats.GameType))
+
+	if len(stats.Skaters) == 0 && len(stats.Goalies) == 0 {
+		fmt.Println("No stats available")
+		return
+	}
+
+	fmt.Println("\nSkaters")
+	fmt.Printf("%-25s %-3s %3s %3s %3s %3s %4s %4s %3s %3s %4s %5s %6s\n",
+		"Player", "Pos", "GP", "G", "A", "P", "+/-", "PIM", "PPG", "SHG", "S", "S%", "TOI")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, skater := range stats.Skaters {
+		fmt.Printf("%-25s %-3s %3d %3d %3d %3d %4d %4d %3d %3d %4d %5.1f %6s\n",
+			fmt.Sprintf("%s %s", skater.FirstName.Default, skater.LastName.Default),
+			skater.PositionCode,
+			skater.GamesPlayed,
+			skater.Goals,
+			skater.Assists,
+			skater.Points,
+			skater.PlusMinus,
+			skater.PenaltyMinutes,
+			skater.PowerPlayGoals,
+			skater.ShorthandedGoals,
+			skater.Shots,
+			skater.ShootingPctg*100,
+			formatters.FormatTimeOnIce(int(skater.AvgTimeOnIcePerGame)))
+	}
+
+	fmt.Println("\nGoalies")
+	fmt.Printf("%-25s %3s %3s %3s %3s %3s %5s %6s %3s\n",
+		"Player", "GP", "GS", "W", "L", "OT", "GAA", "SV%", "SO")
+	fmt.Println(strings.Repeat("-", 61))
+	for _, goalie := range stats.Goalies {
+		fmt.Printf("%-25s %3d %3d %3d %3d %3d %5.2f %6.3f %3d\n",
+			fmt.Sprintf("%s %s", goalie.FirstName.Default, goalie.LastName.Default),
+			goalie.GamesPlayed,
+			goalie.GamesStarted,
+			goalie.Wins,
+			goalie.Losses,
+			goalie.OvertimeLosses,
+			goalie.GoalsAgainstAverage,
+			goalie.SavePercentage,
+			goalie.Shutouts)
+	}
+}
+
+// ClubStatsSeasons displays the seasons a team has stats for
+func ClubStatsSeasons(seasons []nhl.ClubStatsSeason, teamName string) {
+	fmt.Printf("\nSeasons with stats for %s\n", teamName)
+	for _, season := range seasons {
+		var gameTypes []string
+		for _, gameType := range season.GameTypes {
+			gameTypes = append(gameTypes, GetGameTypeName(gameType))
+		}
+		fmt.Printf("%s: %s\n", formatters.FormatSeasonID(season.Season), strings.Join(gameTypes, ", "))
+	}
+}
@@ -0,0 +1,94 @@
+package display
+
+import (
+	"fmt"
+	"go-nhl/client"
+	"strings"
+)
+
+// DraftPicks displays the picks made in a draft
+func DraftPicks(picks []nhl.DraftPick, year int) {
+	fmt.Printf("\n%d NHL Draft\n", year)
+
+	if len(picks) == 0 {
+		fmt.Println("No picks found")
+		return
+	}
+
+	fmt.Printf("%-3s %-4s %-4s %-4s %-25s %-3s %-3s %s\n", "Rd", "Pick", "Ovr", "Team", "Player", "Pos", "Nat", "Amateur Club")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, pick := range picks {
+		fmt.Printf("%-3d %-4d %-4d %-4s %-25s %-3s %-3s %s (%s)\n",
+			pick.Round,
+			pick.PickInRound,
+			pick.OverallPick,
+			pick.TeamAbbrev,
+			fmt.Sprintf("%s %s", pick.FirstName.Default, pick.LastName.Default),
+			pick.PositionCode,
+			pick.CountryCode,
+			pick.AmateurClubName,
+			pick.AmateurLeague)
+	}
+}
+
+// DraftRankings displays a Central Scouting prospect list
+func DraftRankings(rankings *nhl.DraftRankings) {
+	fmt.Printf("\n%d Draft Rankings: %s\n", rankings.DraftYear, strings.ReplaceAll(rankings.CategoryKey, "-", " "))
+
+	if len(rankings.Rankings) == 0 {
+		fmt.Println("No prospects ranked")
+		return
+	}
+
+	fmt.Printf("%-5s %-5s %-25s %-3s %-30s %s\n", "Final", "Mid", "Player", "Pos", "Amateur Club", "Born")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, prospect := range rankings.Rankings {
+		fmt.Printf("%-5s %-5s %-25s %-3s %-30s %s\n",
+			rank(prospect.FinalRank),
+			rank(prospect.MidtermRank),
+			fmt.Sprintf("%s %s", prospect.FirstName, prospect.LastName),
+			prospect.PositionCode,
+			fmt.Sprintf("%s (%s)", prospect.LastAmateurClub, prospect.LastAmateurLeague),
+			prospect.BirthDate)
+	}
+}
+
+// DraftDetails displays where a player was drafted
+func DraftDetails(details *nhl.DraftDetails, playerName string) {
+	if details == nil {
+		fmt.Printf("\n%s was never drafted\n", playerName)
+		return
+	}
+	fmt.Printf("\n%s was drafted %s overall by %s in %d (round %d, pick %d)\n",
+		playerName,
+		ordinal(details.OverallPick),
+		details.TeamAbbrev,
+		details.Year,
+		details.Round,
+		details.PickInRound)
+}
+
+// rank formats a prospect rank, or "-" when the prospect was not ranked
+func rank(r int) string {
+	if r == 0 {
+		return "-"
+	}
+	return fmt.Sprint(r)
+}
+
+// ordinal formats n as 1st, 2nd, 3rd, 4th and so on
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,87 @@
+package display
+
+import (
+	"fmt"
+	"go-nhl/client"
+	"go-nhl/internal/formatters"
+	"strings"
+)
+
+// GameLog displays a player's game-by-game stats followed by their totals
+func GameLog(log *nhl.PlayerGameLog, playerName string) {
+	fmt.Printf("\nGame Log for %s (%s %s)\n", playerName, formatters.FormatSeasonID(log.SeasonID), GetGameTypeName(log.GameType))
+
+	if len(log.Skaters) == 0 && len(log.Goalies) == 0 {
+		fmt.Println("No games played")
+		return
+	}
+
+	if log.Goalie {
+		goalieGameLog(log)
+		return
+	}
+	skaterGameLog(log)
+}
+
+// skaterGameLog displays a skater's games and totals
+func skaterGameLog(log *nhl.PlayerGameLog) {
+	fmt.Printf("%-10s %-7s %3s %3s %3s %4s %3s %3s %4s %6s\n", "Date", "Opp", "G", "A", "P", "+/-", "PPP", "SOG", "PIM", "TOI")
+	fmt.Println(strings.Repeat("-", 58))
+	for _, game := range log.Skaters {
+		fmt.Printf("%-10s %-7s %3d %3d %3d %4d %3d %3d %4d %6s\n",
+			game.GameDate,
+			opponent(game.HomeRoadFlag, game.OpponentAbbrev),
+			game.Goals,
+			game.Assists,
+			game.Points,
+			game.PlusMinus,
+			game.PowerPlayPoints,
+			game.Shots,
+			game.PenaltyMinutes,
+			game.TOI)
+	}
+
+	totals := log.SkaterTotals()
+	fmt.Printf("\nTotals (%d games):\n", totals.GamesPlayed)
+	fmt.Printf("Goals: %d\n", totals.Goals)
+	fmt.Printf("Assists: %d\n", totals.Assists)
+	fmt.Printf("Points: %d (%.2f per game)\n", totals.Points, float64(totals.Points)/float64(totals.GamesPlayed))
+	fmt.Printf("Plus/Minus: %d\n", totals.PlusMinus)
+	fmt.Printf("Power Play Points: %d\n", totals.PowerPlayPoints)
+	fmt.Printf("Shots: %d (%.1f%%)\n", totals.Shots, totals.ShootingPctg*100)
+	fmt.Printf("PIM: %d\n", totals.PenaltyMinutes)
+	fmt.Printf("TOI/Game: %s\n", totals.AvgTOI)
+}
+
+// goalieGameLog displays a goalie's games and totals
+func goalieGameLog(log *nhl.PlayerGameLog) {
+	fmt.Printf("%-10s %-7s %3s %3s %3s %3s %6s %6s\n", "Date", "Opp", "GS", "Dec", "SA", "GA", "SV%", "TOI")
+	fmt.Println(strings.Repeat("-", 50))
+	for _, game := range log.Goalies {
+		fmt.Printf("%-10s %-7s %3d %3s %3d %3d %6.3f %6s\n",
+			game.GameDate,
+			opponent(game.HomeRoadFlag, game.OpponentAbbrev),
+			game.GamesStarted,
+			game.Decision,
+			game.ShotsAgainst,
+			game.GoalsAgainst,
+			game.SavePctg,
+			game.TOI)
+	}
+
+	totals := log.GoalieTotals()
+	fmt.Printf("\nTotals (%d games, %d starts):\n", totals.GamesPlayed, totals.GamesStarted)
+	fmt.Printf("Record: %d-%d-%d\n", totals.Wins, totals.Losses, totals.OTLosses)
+	fmt.Printf("Save %%: %.3f\n", totals.SavePctg)
+	fmt.Printf("GAA: %.2f\n", totals.GoalsAgainstAverage)
+	fmt.Printf("Shutouts: %d\n", totals.Shutouts)
+	fmt.Printf("TOI: %s\n", totals.TOI)
+}
+
+// opponent formats an opponent as "vs DAL" at home or "@ DAL" on the road
+func opponent(homeRoadFlag, abbrev string) string {
+	if homeRoadFlag == "H" {
+		return "vs " + abbrev
+	}
+	return "@ " + abbrev
+}
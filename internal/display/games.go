@@ -2,8 +2,9 @@ package display
 
 import (
 	"fmt"
-	"go-nhl/internal/formatters"
 	"go-nhl/client"
+	"go-nhl/internal/formatters"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -32,12 +33,12 @@ func Games(games *nhl.FilteredScoreboardResponse) {
 			game.HomeTeam.Name.Default,
 			gameTime)
 
-		if game.GameState == "LIVE" || game.GameState == "OFF" {
+		if game.GameState.IsLive() || game.GameState.IsFinal() {
 			fmt.Printf("Score: %s %d, %s %d\n",
 				game.AwayTeam.Name.Default, game.AwayTeam.Score,
 				game.HomeTeam.Name.Default, game.HomeTeam.Score)
 
-			if game.GameState == "LIVE" {
+			if game.GameState.IsLive() {
 				fmt.Printf("Period: %d (%s)\n",
 					game.Period,
 					game.PeriodDescriptor.PeriodType)
@@ -45,12 +46,97 @@ func Games(games *nhl.FilteredScoreboardResponse) {
 		} else {
 			fmt.Printf("Game Status: %s\n", game.GameState)
 		}
+		if len(game.TVBroadcasts) > 0 {
+			fmt.Printf("TV: %s\n", broadcastList(game.TVBroadcasts))
+		}
 		fmt.Println()
 	}
 }
 
-// GameDetails displays detailed information about a specific game
-func GameDetails(game *nhl.GameDetails, boxscore *nhl.BoxscoreResponse) {
+// LeagueSchedule displays the league's games over a date range, day by day
+func LeagueSchedule(schedule *nhl.LeagueSchedule) {
+	fmt.Printf("\nGames from %s to %s:\n", schedule.StartDate, schedule.EndDate)
+
+	if len(schedule.GameDays) == 0 {
+		fmt.Println("No games found")
+		return
+	}
+
+	for _, day := range schedule.GameDays {
+		heading := day.Date
+		if date, err := time.Parse("2006-01-02", day.Date); err == nil {
+			heading = date.Format("Monday, January 2")
+		}
+		fmt.Printf("\n%s\n", heading)
+		for _, game := range day.Games {
+			fmt.Printf("  %-3s @ %-3s  %s", game.AwayTeam.Abbrev, game.HomeTeam.Abbrev, scheduledGameStatus(game))
+			if len(game.TVBroadcasts) > 0 {
+				fmt.Printf("  TV: %s", broadcastList(game.TVBroadcasts))
+			}
+			fmt.Println()
+		}
+	}
+}
+
+// TeamBroadcasts displays the networks carrying each game of a team's season
+func TeamBroadcasts(teamName string, broadcasts *nhl.TeamBroadcasts) {
+	fmt.Printf("TV schedule for %s (%d-%d):\n", teamName, broadcasts.Season/10000, broadcasts.Season/10000+1)
+
+	if len(broadcasts.Games) == 0 {
+		fmt.Println("No games found")
+		return
+	}
+
+	for _, game := range broadcasts.Games {
+		matchup := "@ " + game.HomeTeam.Abbreviation
+		if game.HomeTeam.Abbreviation == broadcasts.Team {
+			matchup = "vs " + game.AwayTeam.Abbreviation
+		}
+		networks := "no TV listed"
+		if len(game.TVBroadcasts) > 0 {
+			networks = broadcastList(game.TVBroadcasts)
+		}
+		fmt.Printf("%s: %-6s  %s\n", game.GameDate, matchup, networks)
+	}
+}
+
+// broadcastList describes broadcasts by network, country and market, such
+// as "SN (CA national), MSG (US home)"
+func broadcastList(broadcasts []nhl.TVBroadcast) string {
+	markets := map[string]string{nhl.MarketNational: "national", nhl.MarketHome: "home", nhl.MarketAway: "away"}
+	names := make([]string, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		detail := strings.TrimSpace(b.CountryCode + " " + markets[b.Market])
+		if detail == "" {
+			names = append(names, b.Network)
+			continue
+		}
+		names = append(names, fmt.Sprintf("%s (%s)", b.Network, detail))
+	}
+	return strings.Join(names, ", ")
+}
+
+// scheduledGameStatus describes a schedule game by its score once it has
+// started, or by its start time
+func scheduledGameStatus(game nhl.Game) string {
+	switch {
+	case game.GameState.IsLive():
+		return fmt.Sprintf("%d-%d (live)", game.AwayTeam.Score, game.HomeTeam.Score)
+	case game.GameState.IsFinal():
+		return fmt.Sprintf("%d-%d (final)", game.AwayTeam.Score, game.HomeTeam.Score)
+	}
+	gameTime, err := formatters.FormatGameTime(game.StartTimeUTC)
+	if err != nil {
+		return string(game.GameState)
+	}
+	return gameTime
+}
+
+// GameDetails displays detailed information about a specific game. The right
+// rail adds the linescore, the teams' stats side by side, the season series,
+// each team's last 10 games and the officials, coaches and scratches; it may
+// be nil, in which case team stats are totalled from the boxscore.
+func GameDetails(game *nhl.GameDetails, boxscore *nhl.BoxscoreResponse, rail *nhl.GameRightRail) {
 	fmt.Printf("\nGame Details:\n")
 	fmt.Printf("Date: %s\n", game.GameDate)
 	fmt.Printf("Start Time (UTC): %s\n", game.StartTimeUTC)
@@ -63,7 +149,78 @@ func GameDetails(game *nhl.GameDetails, boxscore *nhl.BoxscoreResponse) {
 	fmt.Printf("%-20s %s\n", game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
 	fmt.Printf("%-20d %d\n", game.AwayTeam.Score, game.HomeTeam.Score)
 
-	// Display team stats
+	if rail != nil && len(rail.Linescore.ByPeriod) > 0 {
+		linescore(rail, game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+	}
+	if rail != nil && len(rail.TeamGameStats) > 0 {
+		teamStatComparison(rail.TeamGameStats, game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+	} else {
+		boxscoreTeamStats(game, boxscore)
+	}
+
+	// Display scoring summary
+	if len(game.Summary.Scoring) > 0 {
+		fmt.Printf("\nScoring Summary:\n")
+		for _, period := range game.Summary.Scoring {
+			if len(period.Goals) > 0 {
+				fmt.Printf("\nPeriod %d:\n", period.PeriodDescriptor.Number)
+				for _, goal := range period.Goals {
+					fmt.Printf("%s - %s (%s) %s\n",
+						goal.TimeInPeriod,
+						goal.Name.Default,
+						goal.TeamAbbrev.Default,
+						formatAssists(goal.Assists))
+				}
+			}
+		}
+	}
+
+	// Display penalty summary
+	if len(game.Summary.Penalties) > 0 {
+		fmt.Printf("\nPenalty Summary:\n")
+		for _, period := range game.Summary.Penalties {
+			if len(period.Penalties) > 0 {
+				fmt.Printf("\nPeriod %d:\n", period.PeriodDescriptor.Number)
+				for _, penalty := range period.Penalties {
+					fmt.Printf("%s - %s %s (%d min) drawn by %s\n",
+						penalty.TimeInPeriod,
+						formatPenaltyPlayer(penalty.CommittedByPlayer),
+						penalty.DescKey,
+						penalty.Duration,
+						formatPenaltyPlayer(penalty.DrawnBy))
+				}
+			}
+		}
+	}
+
+	// Display three stars
+	if len(game.ThreeStars) > 0 {
+		fmt.Printf("\nThree Stars:\n")
+		for _, star := range game.ThreeStars {
+			var stats string
+			if star.Position.IsGoalie() {
+				stats = fmt.Sprintf("Save %%: %.1f", star.SavePctg*100)
+			} else {
+				stats = fmt.Sprintf("G: %d, A: %d, P: %d", star.Goals, star.Assists, star.Points)
+			}
+			fmt.Printf("%d. %s (%s) - %s #%d - %s\n",
+				star.Star,
+				star.Name.Default,
+				star.TeamAbbrev,
+				star.Position,
+				star.SweaterNo,
+				stats)
+		}
+	}
+
+	if rail != nil {
+		seasonSeries(rail, game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+		gameInfo(rail, game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+	}
+}
+
+// boxscoreTeamStats displays team stats totalled from the players' stats
+func boxscoreTeamStats(game *nhl.GameDetails, boxscore *nhl.BoxscoreResponse) {
 	fmt.Printf("\nTeam Stats:\n")
 	fmt.Printf("%-6s %3s %3s %3s %5s %4s\n", "Team", "G", "SOG", "HIT", "FO%", "PIM")
 
@@ -122,61 +279,165 @@ func GameDetails(game *nhl.GameDetails, boxscore *nhl.BoxscoreResponse) {
 		homeHits,
 		homeFOPct*100,
 		homePIM)
+}
 
-	// Display scoring summary
-	if len(game.Summary.Scoring) > 0 {
-		fmt.Printf("\nScoring Summary:\n")
-		for _, period := range game.Summary.Scoring {
-			if len(period.Goals) > 0 {
-				fmt.Printf("\nPeriod %d:\n", period.PeriodDescriptor.Number)
-				for _, goal := range period.Goals {
-					fmt.Printf("%s - %s (%s) %s\n",
-						goal.TimeInPeriod,
-						goal.Name.Default,
-						goal.TeamAbbrev.Default,
-						formatAssists(goal.Assists))
-				}
-			}
+// linescore displays goals and shots by period
+func linescore(rail *nhl.GameRightRail, away, home string) {
+	fmt.Printf("\nLinescore:\n")
+	fmt.Printf("%-6s", "Team")
+	for _, period := range rail.Linescore.ByPeriod {
+		fmt.Printf(" %3s", periodLabel(period.PeriodDescriptor))
+	}
+	fmt.Printf(" %3s %4s\n", "T", "SOG")
+
+	awayShots, homeShots := 0, 0
+	for _, period := range rail.ShotsByPeriod {
+		awayShots += period.Away
+		homeShots += period.Home
+	}
+	for _, side := range []struct {
+		abbrev string
+		goals  func(nhl.PeriodScore) int
+		total  int
+		shots  int
+	}{
+		{away, func(p nhl.PeriodScore) int { return p.Away }, rail.Linescore.Totals.Away, awayShots},
+		{home, func(p nhl.PeriodScore) int { return p.Home }, rail.Linescore.Totals.Home, homeShots},
+	} {
+		fmt.Printf("%-6s", side.abbrev)
+		for _, period := range rail.Linescore.ByPeriod {
+			fmt.Printf(" %3d", side.goals(period))
 		}
+		fmt.Printf(" %3d %4d\n", side.total, side.shots)
 	}
+}
 
-	// Display penalty summary
-	if len(game.Summary.Penalties) > 0 {
-		fmt.Printf("\nPenalty Summary:\n")
-		for _, period := range game.Summary.Penalties {
-			if len(period.Penalties) > 0 {
-				fmt.Printf("\nPeriod %d:\n", period.PeriodDescriptor.Number)
-				for _, penalty := range period.Penalties {
-					fmt.Printf("%s - %s %s (%d min) drawn by %s\n",
-						penalty.TimeInPeriod,
-						penalty.CommittedByPlayer,
-						penalty.DescKey,
-						penalty.Duration,
-						penalty.DrawnBy)
+// periodLabel names a period 1, 2, 3, OT, 2OT and so on, or SO
+func periodLabel(period nhl.PeriodDescriptor) string {
+	switch {
+	case period.PeriodType.IsShootout():
+		return "SO"
+	case period.PeriodType.IsOvertime():
+		regulation := period.MaxRegulationPeriods
+		if regulation == 0 {
+			regulation = 3
+		}
+		if n := period.Number - regulation; n > 1 {
+			return fmt.Sprintf("%dOT", n)
+		}
+		return "OT"
+	}
+	return fmt.Sprint(period.Number)
+}
+
+// teamStatNames labels the right rail's team stat categories, in display order
+var teamStatNames = []struct {
+	category string
+	name     string
+}{
+	{nhl.TeamStatShotsOnGoal, "Shots"},
+	{nhl.TeamStatFaceoffPctg, "Faceoff %"},
+	{nhl.TeamStatPowerPlay, "Power Play"},
+	{nhl.TeamStatPIM, "PIM"},
+	{nhl.TeamStatHits, "Hits"},
+	{nhl.TeamStatBlockedShots, "Blocked Shots"},
+	{nhl.TeamStatGiveaways, "Giveaways"},
+	{nhl.TeamStatTakeaways, "Takeaways"},
+}
+
+// teamStatComparison displays the teams' game stats side by side
+func teamStatComparison(stats []nhl.TeamStatComparison, away, home string) {
+	byCategory := make(map[string]nhl.TeamStatComparison, len(stats))
+	for _, stat := range stats {
+		byCategory[stat.Category] = stat
+	}
+
+	fmt.Printf("\nTeam Stats:\n")
+	fmt.Printf("%-14s %6s %6s\n", "", away, home)
+	for _, label := range teamStatNames {
+		stat, ok := byCategory[label.category]
+		if !ok {
+			continue
+		}
+		awayValue, homeValue := string(stat.AwayValue), string(stat.HomeValue)
+		if label.category == nhl.TeamStatFaceoffPctg {
+			awayValue = fmt.Sprintf("%.1f%%", stat.AwayValue.Float()*100)
+			homeValue = fmt.Sprintf("%.1f%%", stat.HomeValue.Float()*100)
+		}
+		fmt.Printf("%-14s %6s %6s\n", label.name, awayValue, homeValue)
+	}
+}
+
+// seasonSeries displays the season's games between the teams and how each
+// team has done over its last 10 games
+func seasonSeries(rail *nhl.GameRightRail, away, home string) {
+	if len(rail.SeasonSeries) > 0 {
+		wins := rail.SeasonSeriesWins
+		status := fmt.Sprintf("tied %d-%d", wins.AwayTeamWins, wins.HomeTeamWins)
+		if wins.AwayTeamWins > wins.HomeTeamWins {
+			status = fmt.Sprintf("%s leads %d-%d", away, wins.AwayTeamWins, wins.HomeTeamWins)
+		} else if wins.HomeTeamWins > wins.AwayTeamWins {
+			status = fmt.Sprintf("%s leads %d-%d", home, wins.HomeTeamWins, wins.AwayTeamWins)
+		}
+
+		fmt.Printf("\nSeason Series (%s):\n", status)
+		for _, game := range rail.SeasonSeries {
+			switch {
+			case game.GameState.IsFinal():
+				result := "Final"
+				if game.GameOutcome != nil && !game.GameOutcome.LastPeriodType.IsRegulation() {
+					result += "/" + string(game.GameOutcome.LastPeriodType)
 				}
+				fmt.Printf("%s  %s %d @ %s %d  %s\n", game.GameDate, game.AwayTeam.Abbrev, game.AwayTeam.Score, game.HomeTeam.Abbrev, game.HomeTeam.Score, result)
+			default:
+				fmt.Printf("%s  %s @ %s\n", game.GameDate, game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
 			}
 		}
 	}
 
-	// Display three stars
-	if len(game.ThreeStars) > 0 {
-		fmt.Printf("\nThree Stars:\n")
-		for _, star := range game.ThreeStars {
-			var stats string
-			if star.Position == "G" {
-				stats = fmt.Sprintf("Save %%: %.1f", star.SavePctg*100)
-			} else {
-				stats = fmt.Sprintf("G: %d, A: %d, P: %d", star.Goals, star.Assists, star.Points)
-			}
-			fmt.Printf("%d. %s (%s) - %s #%d - %s\n",
-				star.Star,
-				star.Name.Default,
-				star.TeamAbbrev,
-				star.Position,
-				star.SweaterNo,
-				stats)
+	if rail.Last10Record != nil {
+		fmt.Printf("\nLast 10 Games:\n")
+		for _, team := range []struct {
+			abbrev string
+			record nhl.Last10Record
+		}{{away, rail.Last10Record.AwayTeam}, {home, rail.Last10Record.HomeTeam}} {
+			fmt.Printf("%-6s %s (streak: %s%d)\n", team.abbrev, team.record.Record, team.record.StreakType, team.record.Streak)
+		}
+	}
+}
+
+// gameInfo displays the officials, head coaches and scratches
+func gameInfo(rail *nhl.GameRightRail, away, home string) {
+	info := rail.GameInfo
+	if len(info.Referees) == 0 && len(info.Linesmen) == 0 && info.AwayTeam.HeadCoach.Default == "" && info.HomeTeam.HeadCoach.Default == "" {
+		return
+	}
+
+	fmt.Printf("\nGame Info:\n")
+	fmt.Printf("Referees: %s\n", joinNames(info.Referees))
+	fmt.Printf("Linesmen: %s\n", joinNames(info.Linesmen))
+	for _, team := range []struct {
+		abbrev string
+		info   nhl.GameInfoTeam
+	}{{away, info.AwayTeam}, {home, info.HomeTeam}} {
+		scratches := make([]string, 0, len(team.info.Scratches))
+		for _, player := range team.info.Scratches {
+			scratches = append(scratches, fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default))
+		}
+		if len(scratches) == 0 {
+			scratches = append(scratches, "None")
 		}
+		fmt.Printf("%s: Coach %s, Scratches: %s\n", team.abbrev, team.info.HeadCoach.Default, strings.Join(scratches, ", "))
+	}
+}
+
+// joinNames joins names with commas
+func joinNames(names []nhl.LanguageNames) string {
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name.Default
 	}
+	return strings.Join(parts, ", ")
 }
 
 // Helper function to format assists from the new model
@@ -192,6 +453,14 @@ func formatAssists(assists []nhl.AssistEvent) string {
 	return strings.Join(names, ", ")
 }
 
+// Helper function to format the player committing or drawing a penalty
+func formatPenaltyPlayer(player *nhl.PenaltyPlayerInfo) string {
+	if player == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default)
+}
+
 // GameBoxscore displays the boxscore for a game
 func GameBoxscore(boxscore *nhl.BoxscoreResponse) {
 	fmt.Printf("\nBoxscore Summary\n")
@@ -348,78 +617,119 @@ func GameBoxscore(boxscore *nhl.BoxscoreResponse) {
 	}
 }
 
-// GamePlayByPlay displays play-by-play data for a game
-func GamePlayByPlay(pbp *nhl.PlayByPlayResponse) {
+// GamePlayByPlay displays play-by-play data for a game, limited to the
+// plays passing filter. Without a filter on event types, events such as
+// stoppages and takeaways are left out.
+func GamePlayByPlay(pbp *nhl.PlayByPlayResponse, filter *nhl.PlayFilter) {
 	if len(pbp.Plays) == 0 {
 		fmt.Println("\nNo play-by-play data available.")
 		return
 	}
 
-	// Create a map of player IDs to names
-	players := make(map[int]string)
-	for _, player := range pbp.RosterSpots {
-		players[player.PlayerID] = fmt.Sprintf("%s %s (%d)",
-			player.FirstName.Default,
-			player.LastName.Default,
-			player.SweaterNumber)
+	// Name players with their sweater numbers
+	player := func(playerID int) string {
+		spot, ok := pbp.Player(playerID)
+		if !ok {
+			return ""
+		}
+		return fmt.Sprintf("%s (%d)", spot.Name(), spot.SweaterNumber)
+	}
+	team := func(teamID int) string {
+		if teamID == pbp.HomeTeam.ID {
+			return pbp.HomeTeam.Abbrev
+		}
+		return pbp.AwayTeam.Abbrev
+	}
+
+	plays := pbp.FilterPlays(filter)
+	if filter.IsZero() || len(filter.Types) == 0 {
+		plays = slices.DeleteFunc(slices.Clone(plays), func(play nhl.PlayEvent) bool {
+			switch play.TypeDescKey {
+			case nhl.EventPeriodStart, nhl.EventPeriodEnd, nhl.EventGameEnd, nhl.EventStoppage,
+				nhl.EventGiveaway, nhl.EventTakeaway, nhl.EventDelayedPenalty:
+				return true
+			}
+			return false
+		})
+	}
+	if len(plays) == 0 {
+		fmt.Println("\nNo plays match the filters.")
+		return
 	}
 
 	fmt.Printf("\nPlay-by-Play:\n")
 	fmt.Printf("%-6s %-8s %-8s %-50s\n", "Period", "Time", "Remain", "Event")
 	fmt.Println(strings.Repeat("-", 80))
 
-	for _, play := range pbp.Plays {
-		// Skip non-significant events
-		switch play.TypeDescKey {
-		case "period-start", "period-end", "game-end", "stoppage",
-			"giveaway", "takeaway", "delayed-penalty":
-			continue
-		}
-
+	for _, play := range plays {
 		// Format event description based on type
 		var description string
-		switch play.TypeDescKey {
-		case "shot-on-goal":
-			shooter := players[play.Details.ShootingPlayerID]
-			goalie := players[play.Details.GoalieInNetID]
-			description = fmt.Sprintf("Shot by %s, saved by %s", shooter, goalie)
-		case "goal":
-			scorer := players[play.Details.ScoringPlayerID]
-			description = fmt.Sprintf("GOAL! Scored by %s", scorer)
-			if play.Details.Assist1PlayerID > 0 {
-				assist1 := players[play.Details.Assist1PlayerID]
-				if play.Details.Assist2PlayerID > 0 {
-					assist2 := players[play.Details.Assist2PlayerID]
-					description += fmt.Sprintf(" (Assists: %s, %s)", assist1, assist2)
-				} else {
-					description += fmt.Sprintf(" (Assist: %s)", assist1)
-				}
+		switch play.Type() {
+		case nhl.EventShotOnGoal:
+			shot, _ := play.ShotOnGoal()
+			description = fmt.Sprintf("Shot by %s", player(shot.ShooterID))
+			if shot.GoalieID != 0 {
+				description += fmt.Sprintf(", saved by %s", player(shot.GoalieID))
+			}
+		case nhl.EventGoal:
+			goal, _ := play.Goal()
+			description = fmt.Sprintf("GOAL! Scored by %s", player(goal.Scorer.PlayerID))
+			switch len(goal.Assists) {
+			case 1:
+				description += fmt.Sprintf(" (Assist: %s)", player(goal.Assists[0].PlayerID))
+			case 2:
+				description += fmt.Sprintf(" (Assists: %s, %s)", player(goal.Assists[0].PlayerID), player(goal.Assists[1].PlayerID))
+			}
+		case nhl.EventBlockedShot:
+			blocked, _ := play.BlockedShot()
+			description = fmt.Sprintf("Shot by %s blocked by %s", player(blocked.ShooterID), player(blocked.BlockerID))
+		case nhl.EventMissedShot:
+			missed, _ := play.MissedShot()
+			description = fmt.Sprintf("Shot by %s (%s)", player(missed.ShooterID), missed.Reason)
+		case nhl.EventFailedShotAttempt:
+			attempt, _ := play.FailedShotAttempt()
+			description = fmt.Sprintf("Shootout attempt by %s failed", player(attempt.ShooterID))
+		case nhl.EventHit:
+			hit, _ := play.Hit()
+			description = fmt.Sprintf("%s hit %s", player(hit.HitterID), player(hit.HitteeID))
+		case nhl.EventFaceoff:
+			faceoff, _ := play.Faceoff()
+			description = fmt.Sprintf("Faceoff won by %s vs %s", player(faceoff.WinnerID), player(faceoff.LoserID))
+		case nhl.EventPenalty:
+			penalty, _ := play.Penalty()
+			// Bench minors are committed by no one player
+			committedBy := player(penalty.CommittedByID)
+			if committedBy == "" {
+				committedBy = team(penalty.TeamID) + " bench"
+			}
+			description = fmt.Sprintf("%s %s (%d min)", committedBy, penalty.Desc, penalty.Minutes)
+			if penalty.DrawnByID != 0 {
+				description += fmt.Sprintf(" drawn by %s", player(penalty.DrawnByID))
 			}
-		case "blocked-shot":
-			shooter := players[play.Details.ShootingPlayerID]
-			blocker := players[play.Details.BlockingPlayerID]
-			description = fmt.Sprintf("Shot by %s blocked by %s", shooter, blocker)
-		case "missed-shot":
-			shooter := players[play.Details.ShootingPlayerID]
-			description = fmt.Sprintf("Shot by %s (%s)", shooter, play.Details.Reason)
-		case "hit":
-			hitter := players[play.Details.HittingPlayerID]
-			hittee := players[play.Details.HitteePlayerID]
-			description = fmt.Sprintf("%s hit %s", hitter, hittee)
-		case "faceoff":
-			winner := players[play.Details.WinningPlayerID]
-			loser := players[play.Details.LosingPlayerID]
-			description = fmt.Sprintf("Faceoff won by %s vs %s", winner, loser)
-		case "penalty":
-			offender := players[play.Details.CommittedByPlayerID]
-			drawer := players[play.Details.DrawnByPlayerID]
-			description = fmt.Sprintf("%s %s (%d min) drawn by %s",
-				offender,
-				play.Details.DescKey,
-				play.Details.Duration,
-				drawer)
+		case nhl.EventGiveaway:
+			giveaway, _ := play.Giveaway()
+			description = fmt.Sprintf("Giveaway by %s", player(giveaway.PlayerID))
+		case nhl.EventTakeaway:
+			takeaway, _ := play.Takeaway()
+			description = fmt.Sprintf("Takeaway by %s", player(takeaway.PlayerID))
+		case nhl.EventDelayedPenalty:
+			delayed, _ := play.DelayedPenalty()
+			description = fmt.Sprintf("Delayed penalty on %s", team(delayed.TeamID))
+		case nhl.EventStoppage:
+			stoppage, _ := play.Stoppage()
+			description = fmt.Sprintf("Stoppage (%s)", stoppage.Reason)
+		case nhl.EventPeriodStart:
+			period, _ := play.PeriodStart()
+			description = fmt.Sprintf("Start of period %d", period.Number)
+		case nhl.EventPeriodEnd:
+			period, _ := play.PeriodEnd()
+			description = fmt.Sprintf("End of period %d", period.Number)
+		case nhl.EventShootoutComplete:
+			description = "Shootout complete"
+		case nhl.EventGameEnd:
+			description = "End of game"
 		default:
-			description = play.TypeDescKey
+			description = string(play.TypeDescKey)
 		}
 
 		fmt.Printf("%-6d %-8s %-8s %-50s\n",
@@ -473,10 +783,9 @@ func LiveGameUpdates(updates *nhl.ScoreboardResponse) {
 			timeSinceEnd := now.Sub(gameTime)
 			timeUntilStart := gameTime.Sub(now)
 
-			if game.GameState == "LIVE" ||
-				(game.GameState == "FINAL" && timeSinceEnd < time.Hour) ||
-				(game.GameState == "OFF" && timeSinceEnd < time.Hour) ||
-				(game.GameState == "PRE" && timeUntilStart < time.Hour) {
+			if game.GameState.IsLive() ||
+				(game.GameState.IsFinal() && timeSinceEnd < time.Hour) ||
+				(game.GameState == nhl.GameStatePregame && timeUntilStart < time.Hour) {
 				activeGames = append(activeGames, game)
 			}
 		}
@@ -489,32 +798,32 @@ func LiveGameUpdates(updates *nhl.ScoreboardResponse) {
 			for _, game := range activeGames {
 				// Display game status
 				var statusText string
-				switch game.GameState {
-				case "LIVE":
+				switch {
+				case game.GameState.IsLive():
 					statusText = fmt.Sprintf("LIVE - Period %d, %s", game.Period, game.Clock.TimeRemaining)
 					if game.Clock.InIntermission {
 						statusText += " (Intermission)"
 					}
-				case "FINAL", "OFF":
+				case game.GameState.IsFinal():
 					statusText = "FINAL"
 					if game.PeriodDescriptor.Number > 3 {
-						if game.PeriodDescriptor.PeriodType == "OT" {
+						if game.PeriodDescriptor.PeriodType.IsOvertime() {
 							statusText += " (OT)"
-						} else if game.PeriodDescriptor.PeriodType == "SO" {
+						} else if game.PeriodDescriptor.PeriodType.IsShootout() {
 							statusText += " (SO)"
 						}
 					}
-				case "PRE":
+				case game.GameState == nhl.GameStatePregame:
 					localTime, _ := time.Parse("2006-01-02T15:04:05Z", game.StartTimeUTC)
 					statusText = fmt.Sprintf("Starting at %s", localTime.Format("3:04 PM MST"))
 				default:
-					statusText = game.GameState
+					statusText = string(game.GameState)
 				}
 
 				fmt.Printf("\n%s @ %s - %s\n", game.AwayTeam.Name.Default, game.HomeTeam.Name.Default, statusText)
 				fmt.Printf("Score: %s %d, %s %d\n", game.AwayTeam.Abbrev, game.AwayTeam.Score, game.HomeTeam.Abbrev, game.HomeTeam.Score)
 
-				if game.GameState == "LIVE" {
+				if game.GameState.IsLive() {
 					fmt.Printf("Shots on Goal: %s %d, %s %d\n", game.AwayTeam.Abbrev, game.AwayTeam.ShotsOnGoal, game.HomeTeam.Abbrev, game.HomeTeam.ShotsOnGoal)
 
 					// Display power play situation if applicable
@@ -1,8 +1,12 @@
 package display_test
 
 import (
+	"bytes"
 	"go-nhl/internal/display"
 	"go-nhl/client"
+	"io"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -88,7 +92,7 @@ func TestGameDetails(t *testing.T) {
 	}
 
 	// Test display function (no error should occur)
-	display.GameDetails(game, boxscore)
+	display.GameDetails(game, boxscore, nil)
 }
 
 func TestGameBoxscore(t *testing.T) {
@@ -218,7 +222,51 @@ func TestGamePlayByPlay(t *testing.T) {
 	}
 
 	// Test display function (no error should occur)
-	display.GamePlayByPlay(pbp)
+	display.GamePlayByPlay(pbp, nil)
+}
+
+func TestGamePlayByPlayWithoutPlayers(t *testing.T) {
+	pbp := &nhl.PlayByPlayResponse{
+		AwayTeam: nhl.Team{ID: 3, Abbrev: "NYR"},
+		HomeTeam: nhl.Team{ID: 16, Abbrev: "CHI"},
+		Plays: []nhl.PlayEvent{
+			{
+				TypeDescKey:      nhl.EventShotOnGoal,
+				PeriodDescriptor: nhl.PeriodDescriptor{Number: 3},
+				Details:          nhl.EventDetails{EventOwnerTeamID: 3, ShootingPlayerID: 10},
+			},
+			{
+				TypeDescKey:      nhl.EventPenalty,
+				PeriodDescriptor: nhl.PeriodDescriptor{Number: 3},
+				Details:          nhl.EventDetails{EventOwnerTeamID: 16, ServedByPlayerID: 98, DescKey: "too-many-men-on-the-ice", Duration: 2},
+			},
+		},
+		RosterSpots: []nhl.RosterSpot{
+			{PlayerID: 10, FirstName: nhl.LanguageNames{Default: "Artemi"}, LastName: nhl.LanguageNames{Default: "Panarin"}, SweaterNumber: 10},
+		},
+	}
+
+	// Capture stdout
+	old := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	display.GamePlayByPlay(pbp, nil)
+	w.Close()
+	os.Stdout = old
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	output := buf.String()
+
+	for _, want := range []string{"Shot by Artemi Panarin (10) ", "CHI bench too-many-men-on-the-ice (2 min) "} {
+		if !strings.Contains(output, want) {
+			t.Errorf("GamePlayByPlay() output missing %q:\n%s", want, output)
+		}
+	}
+	for _, unwanted := range []string{"saved by", "drawn by"} {
+		if strings.Contains(output, unwanted) {
+			t.Errorf("GamePlayByPlay() output has %q for a missing player:\n%s", unwanted, output)
+		}
+	}
 }
 
 func TestFormatAssists(t *testing.T) {
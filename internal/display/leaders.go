@@ -7,115 +7,72 @@ import (
 	"strings"
 )
 
-// StatsLeaders displays the NHL stats leaders
-func StatsLeaders(leaders *nhl.StatsLeadersResponse, seasonID int) {
-	// If seasonID is 0, use current season
-	if seasonID == 0 {
-		seasonID = formatters.GetCurrentSeasonID()
-	}
-
-	fmt.Printf("\nNHL Stats Leaders (%s)\n", formatters.FormatSeasonID(seasonID))
-	fmt.Println("================")
-
-	// Points Leaders
-	fmt.Println("\nPoints Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "PTS")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.Points {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
-	}
-
-	// Goals Leaders
-	fmt.Println("\nGoals Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "G")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.Goals {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
-	}
+// leaderColumn describes how a leaders category is displayed
+type leaderColumn struct {
+	title  string
+	header string
+	width  int
+	value  func(float64) string
+}
 
-	// Assists Leaders
-	fmt.Println("\nAssists Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "A")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.Assists {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
-	}
+// countValue formats a counting stat such as goals
+func countValue(value float64) string {
+	return fmt.Sprintf("%.0f", value)
+}
 
-	// Power Play Goals Leaders
-	fmt.Println("\nPower Play Goals Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "PPG")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.GoalsPp {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
-	}
+// leaderColumns maps each category to its table
+var leaderColumns = map[nhl.LeaderCategory]leaderColumn{
+	nhl.LeaderPoints:      {"Points Leaders", "PTS", 3, countValue},
+	nhl.LeaderGoals:       {"Goals Leaders", "G", 3, countValue},
+	nhl.LeaderAssists:     {"Assists Leaders", "A", 3, countValue},
+	nhl.LeaderGoalsPp:     {"Power Play Goals Leaders", "PPG", 3, countValue},
+	nhl.LeaderGoalsSh:     {"Short-handed Goals Leaders", "SHG", 3, countValue},
+	nhl.LeaderPlusMinus:   {"Plus/Minus Leaders", "+/-", 3, countValue},
+	nhl.LeaderFaceoffs:    {"Faceoff Leaders", "FO%", 5, func(v float64) string { return fmt.Sprintf("%.1f", v*100) }},
+	nhl.LeaderTOI:         {"Time on Ice Leaders", "TOI", 5, func(v float64) string { return fmt.Sprintf("%02d:%02d", int(v/60), int(v)%60) }},
+	nhl.LeaderPenaltyMins: {"Penalty Minutes Leaders", "PIM", 3, countValue},
+	nhl.LeaderWins:        {"Wins Leaders", "W", 3, countValue},
+	nhl.LeaderSavePctg:    {"Save Percentage Leaders", "SV%", 5, func(v float64) string { return fmt.Sprintf("%.3f", v) }},
+	nhl.LeaderGAA:         {"Goals Against Average Leaders", "GAA", 4, func(v float64) string { return fmt.Sprintf("%.2f", v) }},
+	nhl.LeaderShutouts:    {"Shutouts Leaders", "SO", 3, countValue},
+}
 
-	// Short-handed Goals Leaders
-	fmt.Println("\nShort-handed Goals Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "SHG")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.GoalsSh {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
-	}
+// StatsLeaders displays the NHL skater stats leaders
+func StatsLeaders(leaders *nhl.StatsLeadersResponse, seasonID int) {
+	Leaders(leaders.Leaders(), seasonID, nhl.GameTypeRegularSeason)
+}
 
-	// Plus/Minus Leaders
-	fmt.Println("\nPlus/Minus Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "+/-")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.PlusMinus {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
+// Leaders displays stats leaders for every category present, skaters first
+func Leaders(leaders nhl.Leaders, seasonID int, gameType nhl.GameType) {
+	// If seasonID is 0, use current season
+	if seasonID == 0 {
+		seasonID = formatters.GetCurrentSeasonID()
 	}
 
-	// Faceoff Leaders
-	fmt.Println("\nFaceoff Leaders")
-	fmt.Printf("%-25s %-15s %5s\n", "Player", "Team", "FO%")
-	fmt.Println(strings.Repeat("-", 47))
-	for _, player := range leaders.FaceoffLeaders {
-		fmt.Printf("%-25s %-15s %5.1f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value*100)
+	title := fmt.Sprintf("NHL Stats Leaders (%s)", formatters.FormatSeasonID(seasonID))
+	if gameType == nhl.GameTypePlayoffs {
+		title += " Playoffs"
 	}
+	fmt.Printf("\n%s\n", title)
+	fmt.Println("================")
 
-	// Time on Ice Leaders
-	fmt.Println("\nTime on Ice Leaders")
-	fmt.Printf("%-25s %-15s %8s\n", "Player", "Team", "TOI")
-	fmt.Println(strings.Repeat("-", 50))
-	for _, player := range leaders.TOI {
-		minutes := int(player.Value / 60)
-		seconds := int(player.Value) % 60
-		fmt.Printf("%-25s %-15s %02d:%02d\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			minutes,
-			seconds)
-	}
+	categories := append(append([]nhl.LeaderCategory{}, nhl.SkaterLeaderCategories...), nhl.GoalieLeaderCategories...)
+	for _, category := range categories {
+		players, ok := leaders[category]
+		if !ok {
+			continue
+		}
+		column := leaderColumns[category]
 
-	// Penalty Minutes Leaders
-	fmt.Println("\nPenalty Minutes Leaders")
-	fmt.Printf("%-25s %-15s %3s\n", "Player", "Team", "PIM")
-	fmt.Println(strings.Repeat("-", 45))
-	for _, player := range leaders.PenaltyMins {
-		fmt.Printf("%-25s %-15s %3.0f\n",
-			fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
-			player.TeamAbbrev,
-			player.Value)
+		fmt.Printf("\n%s\n", column.title)
+		fmt.Printf("%-25s %-15s %*s\n", "Player", "Team", column.width, column.header)
+		fmt.Println(strings.Repeat("-", 42+column.width))
+		for _, player := range players {
+			fmt.Printf("%-25s %-15s %*s\n",
+				fmt.Sprintf("%s %s", player.FirstName.Default, player.LastName.Default),
+				player.TeamAbbrev,
+				column.width,
+				column.value(player.Value))
+		}
 	}
 }
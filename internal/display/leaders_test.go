@@ -199,3 +199,57 @@ func TestStatsLeadersEmpty(t *testing.T) {
 		}
 	}
 }
+
+func TestLeadersGoalies(t *testing.T) {
+	leaders := nhl.Leaders{
+		nhl.LeaderSavePctg: []nhl.StatsLeaderPlayer{
+			{
+				FirstName:  nhl.LanguageNames{Default: "Connor"},
+				LastName:   nhl.LanguageNames{Default: "Hellebuyck"},
+				TeamAbbrev: "WPG",
+				Value:      0.921,
+			},
+		},
+		nhl.LeaderGAA: []nhl.StatsLeaderPlayer{
+			{
+				FirstName:  nhl.LanguageNames{Default: "Igor"},
+				LastName:   nhl.LanguageNames{Default: "Shesterkin"},
+				TeamAbbrev: "NYR",
+				Value:      2.58,
+			},
+		},
+	}
+
+	// Capture stdout
+	old := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	Leaders(leaders, 20232024, nhl.GameTypePlayoffs)
+
+	// Restore stdout
+	w.Close()
+	os.Stdout = old
+
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	output := buf.String()
+
+	expectedStrings := []string{
+		"NHL Stats Leaders (2023-2024) Playoffs",
+		"Save Percentage Leaders",
+		"Connor Hellebuyck         WPG             0.921",
+		"Goals Against Average Leaders",
+		"Igor Shesterkin           NYR             2.58",
+	}
+	for _, expected := range expectedStrings {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain '%s', but it didn't", expected)
+		}
+	}
+
+	// Categories that were not requested are left out
+	if strings.Contains(output, "Points Leaders") {
+		t.Error("Expected output to leave out the points leaders")
+	}
+}
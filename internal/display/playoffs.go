@@ -0,0 +1,120 @@
+package display
+
+import (
+	"fmt"
+	"go-nhl/client"
+	"strings"
+)
+
+// PlayoffBracket displays a playoff bracket round by round, each round split
+// by conference
+func PlayoffBracket(bracket *nhl.PlayoffBracket, year int) {
+	fmt.Printf("\n%d Stanley Cup Playoffs\n", year)
+	fmt.Println("==========================")
+
+	if len(bracket.Series) == 0 {
+		fmt.Println("No playoff series")
+		return
+	}
+
+	for round := 1; ; round++ {
+		series := bracket.Round(round)
+		if len(series) == 0 {
+			return
+		}
+
+		fmt.Printf("\n%s\n", series[0].SeriesTitle)
+		conference := ""
+		for _, s := range series {
+			if s.ConferenceName != conference {
+				conference = s.ConferenceName
+				fmt.Printf("  %s Conference\n", conference)
+			}
+			fmt.Printf("    %-2s %-12s %d-%d  %-12s %s\n",
+				s.SeriesLetter,
+				seededTeam(s.TopSeedTeam, s.TopSeedRankAbbrev),
+				s.TopSeedWins,
+				s.BottomSeedWins,
+				seededTeam(s.BottomSeedTeam, s.BottomSeedRankAbbrev),
+				bracketSeriesStatus(s))
+		}
+	}
+}
+
+// PlayoffSeries displays a playoff series and its games
+func PlayoffSeries(series *nhl.PlayoffSeries) {
+	top, bottom := series.TopSeedTeam, series.BottomSeedTeam
+	fmt.Printf("\nSeries %s (%s): %s vs %s\n",
+		strings.ToUpper(series.SeriesLetter),
+		series.RoundAbbrev,
+		top.Name.Default,
+		bottom.Name.Default)
+	fmt.Println(seriesStatus(series.State(), top.Abbrev, bottom.Abbrev, top.SeriesWins, bottom.SeriesWins))
+
+	fmt.Printf("\n%-6s %-10s %-20s %s\n", "Game", "Date", "Matchup", "Result")
+	fmt.Println(strings.Repeat("-", 50))
+	for _, game := range series.Games {
+		date := game.StartTimeUTC
+		if len(date) >= 10 {
+			date = date[:10]
+		}
+
+		matchup := fmt.Sprintf("%s @ %s", game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+		result := ""
+		switch {
+		case game.GameState.IsFinal():
+			matchup = fmt.Sprintf("%s %d @ %s %d", game.AwayTeam.Abbrev, game.AwayTeam.Score, game.HomeTeam.Abbrev, game.HomeTeam.Score)
+			result = "Final"
+			if game.GameOutcome != nil && !game.GameOutcome.LastPeriodType.IsRegulation() {
+				result += "/" + string(game.GameOutcome.LastPeriodType)
+			}
+		case game.IfNecessary:
+			result = "If necessary"
+		}
+		fmt.Printf("%-6d %-10s %-20s %s\n", game.GameNumber, date, matchup, result)
+	}
+}
+
+// seededTeam formats a bracket team as "FLA (D1)", or TBD before it is known
+func seededTeam(team *nhl.PlayoffTeam, rank string) string {
+	if team == nil {
+		return "TBD"
+	}
+	if rank == "" {
+		return team.Abbrev
+	}
+	return fmt.Sprintf("%s (%s)", team.Abbrev, rank)
+}
+
+// bracketSeriesStatus describes a bracket series such as "FLA wins 4-1"
+func bracketSeriesStatus(s nhl.PlayoffBracketSeries) string {
+	var top, bottom string
+	if s.TopSeedTeam != nil {
+		top = s.TopSeedTeam.Abbrev
+	}
+	if s.BottomSeedTeam != nil {
+		bottom = s.BottomSeedTeam.Abbrev
+	}
+	return seriesStatus(s.State(), top, bottom, s.TopSeedWins, s.BottomSeedWins)
+}
+
+// seriesStatus describes a series from its state and each team's wins
+func seriesStatus(state nhl.SeriesState, top, bottom string, topWins, bottomWins int) string {
+	leader, most, least := top, topWins, bottomWins
+	if bottomWins > topWins {
+		leader, most, least = bottom, bottomWins, topWins
+	}
+
+	switch state {
+	case nhl.SeriesUndetermined:
+		return "Awaiting opponent"
+	case nhl.SeriesNotStarted:
+		return "Not started"
+	case nhl.SeriesComplete:
+		return fmt.Sprintf("%s wins %d-%d", leader, most, least)
+	}
+	if topWins == bottomWins {
+		return fmt.Sprintf("Tied %d-%d", topWins, bottomWins)
+	}
+	return fmt.Sprintf("%s leads %d-%d", leader, most, least)
+}
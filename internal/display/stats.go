@@ -208,14 +208,5 @@ func SeasonStats(stats []nhl.SeasonTotal, gameType nhl.GameType) {
 
 // GetGameTypeName returns a human-readable name for a game type
 func GetGameTypeName(gameType nhl.GameType) string {
-	switch gameType {
-	case nhl.GameTypeRegularSeason:
-		return "Regular Season"
-	case nhl.GameTypePlayoffs:
-		return "Playoff"
-	case nhl.GameTypeAllStar:
-		return "All-Star"
-	default:
-		return "Unknown"
-	}
+	return gameType.String()
 }
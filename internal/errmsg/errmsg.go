@@ -0,0 +1,42 @@
+// Package errmsg turns NHL client errors into messages suitable for users
+package errmsg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nhl "go-nhl/client"
+)
+
+// Friendly returns a short explanation of err for people rather than
+// programs. Errors it does not recognize are returned unchanged.
+func Friendly(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var apiErr *nhl.APIError
+	errors.As(err, &apiErr)
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "The request was canceled."
+	case errors.Is(err, context.DeadlineExceeded):
+		return "The NHL API did not respond in time. Try again, or raise the timeout."
+	case errors.Is(err, nhl.ErrNoFixture):
+		return fmt.Sprintf("No recorded response is available offline (%v).", err)
+	case errors.Is(err, nhl.ErrNotFound) && apiErr != nil:
+		return fmt.Sprintf("The NHL API has nothing at %s. Check the game ID, team, player or date.", apiErr.Endpoint)
+	case errors.Is(err, nhl.ErrNotFound):
+		return fmt.Sprintf("Not found: %v.", err)
+	case errors.Is(err, nhl.ErrRateLimited):
+		return "The NHL API is rate limiting requests. Wait a moment and try again."
+	case errors.Is(err, nhl.ErrUnavailable) && apiErr != nil:
+		return fmt.Sprintf("The NHL API is unavailable right now (status %d). Try again later.", apiErr.StatusCode)
+	case errors.Is(err, nhl.ErrDecode):
+		return "The NHL API returned data in an unexpected format."
+	case apiErr != nil:
+		return fmt.Sprintf("The NHL API returned status %d for %s.", apiErr.StatusCode, apiErr.Endpoint)
+	}
+	return err.Error()
+}
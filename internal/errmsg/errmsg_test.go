@@ -0,0 +1,67 @@
+package errmsg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nhl "go-nhl/client"
+	"strings"
+	"testing"
+)
+
+func TestFriendly(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Missing game",
+			err:  fmt.Errorf("failed to get game boxscore: %w", &nhl.APIError{Endpoint: "gamecenter/{id}/boxscore", StatusCode: 404}),
+			want: "nothing at gamecenter/{id}/boxscore",
+		},
+		{
+			name: "Unknown team",
+			err:  fmt.Errorf("team xyz: %w", nhl.ErrNotFound),
+			want: "Not found: team xyz",
+		},
+		{
+			name: "Throttled",
+			err:  &nhl.APIError{Endpoint: "standings/now", StatusCode: 429},
+			want: "rate limiting",
+		},
+		{
+			name: "Outage",
+			err:  &nhl.APIError{Endpoint: "standings/now", StatusCode: 503},
+			want: "unavailable right now (status 503)",
+		},
+		{
+			name: "Other status",
+			err:  &nhl.APIError{Endpoint: "standings/now", StatusCode: 400},
+			want: "status 400 for standings/now",
+		},
+		{
+			name: "Decode failure",
+			err:  fmt.Errorf("%w: unexpected end of JSON input", nhl.ErrDecode),
+			want: "unexpected format",
+		},
+		{
+			name: "Timeout",
+			err:  fmt.Errorf("failed to make request: %w", context.DeadlineExceeded),
+			want: "did not respond in time",
+		},
+		{
+			name: "Unrecognized",
+			err:  errors.New("name cannot be empty"),
+			want: "name cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Friendly(tt.err); !strings.Contains(got, tt.want) {
+				t.Errorf("Friendly() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
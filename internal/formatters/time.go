@@ -10,19 +10,19 @@ func FormatGameTime(utcTime string) (string, error) {
 	// Parse the UTC time
 	t, err := time.Parse(time.RFC3339, utcTime)
 	if err != nil {
-		return "", fmt.Errorf("error parsing time: %v", err)
+		return "", fmt.Errorf("error parsing time: %w", err)
 	}
 
 	// Load EST location
 	est, err := time.LoadLocation("America/New_York")
 	if err != nil {
-		return "", fmt.Errorf("error loading EST location: %v", err)
+		return "", fmt.Errorf("error loading EST location: %w", err)
 	}
 
 	// Load CST location
 	cst, err := time.LoadLocation("America/Chicago")
 	if err != nil {
-		return "", fmt.Errorf("error loading CST location: %v", err)
+		return "", fmt.Errorf("error loading CST location: %w", err)
 	}
 
 	// Convert to EST and CST
@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
 	"go-nhl/cmd"
+	"go-nhl/internal/errmsg"
 	"log"
+	"os"
+	"os/signal"
 )
 
 func main() {
-	config := cmd.NewConfig()
-	config.ParseFlags()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-	if err := config.Execute(); err != nil {
+	config := cmd.NewConfig()
+	if err := config.ParseFlags(); err != nil {
 		log.Fatal(err)
 	}
+
+	if err := config.Execute(ctx); err != nil {
+		log.Fatal(errmsg.Friendly(err))
+	}
 }
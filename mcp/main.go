@@ -1,11 +1,22 @@
 package main
 
 import (
+	"go-nhl/internal/config"
 	"go-nhl/mcp/server"
 	"log"
 )
 
 func main() {
+	clientConfig, err := config.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client, err := clientConfig.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	server.SetClient(client)
+
 	if err := server.Start(); err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	nhl "go-nhl/client"
+	"sync"
+)
+
+var (
+	clientMu     sync.RWMutex
+	sharedClient = nhl.NewClient()
+)
+
+// SetClient sets the NHL API client used by the tool handlers.
+// Call it before serving requests to configure base URLs, timeouts and headers.
+func SetClient(client *nhl.Client) {
+	clientMu.Lock()
+	defer clientMu.Unlock()
+	sharedClient = client
+}
+
+// getClient returns the NHL API client shared by the tool handlers
+func getClient() *nhl.Client {
+	clientMu.RLock()
+	defer clientMu.RUnlock()
+	return sharedClient
+}
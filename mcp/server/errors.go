@@ -0,0 +1,13 @@
+package server
+
+import (
+	"go-nhl/internal/errmsg"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// apiErrorResult reports a failed NHL API call to the model as a tool error
+// with a friendly explanation, rather than failing the protocol request
+func apiErrorResult(action string, err error) (*mcp.CallToolResult, error) {
+	return mcp.NewToolResultErrorf("Error %s: %s", action, errmsg.Friendly(err)), nil
+}
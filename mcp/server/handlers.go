@@ -6,6 +6,7 @@ import (
 	"fmt"
 	nhl "go-nhl/client"
 	"go-nhl/internal/formatters"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,7 +15,7 @@ import (
 
 var (
 	SlateHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
 		var date string
 		if dateArg, ok := request.GetArguments()["date"]; ok && dateArg != nil {
@@ -26,21 +27,110 @@ var (
 		if date == "" {
 			date = time.Now().Format("2006-01-02")
 		}
-
-		result, err := client.GetScheduleByDate(date, nhl.SortByDateDesc)
+		broadcasts, err := broadcastFilterArgs(request.GetArguments())
 		if err != nil {
 			return nil, err
 		}
 
+		result, err := client.GetScheduleByDate(ctx, date, nhl.SortByDateDesc)
+		if err != nil {
+			return apiErrorResult("getting schedule", err)
+		}
+		result.Games = broadcasts.Games(result.Games)
+
+		jsonData, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling response: %w", err)
+		}
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	LeagueScheduleHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client := getClient()
+
+		var start, end string
+		if startArg, ok := request.GetArguments()["startDate"]; ok && startArg != nil {
+			start, ok = startArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, startDate must be a string in YYYY-MM-DD format")
+			}
+		}
+		if endArg, ok := request.GetArguments()["endDate"]; ok && endArg != nil {
+			end, ok = endArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, endDate must be a string in YYYY-MM-DD format")
+			}
+		}
+		if start == "" {
+			// Monday of the current week
+			today := time.Now()
+			start = today.AddDate(0, 0, -(int(today.Weekday())+6)%7).Format("2006-01-02")
+		}
+		if end == "" {
+			from, err := time.Parse("2006-01-02", start)
+			if err != nil {
+				return nil, fmt.Errorf("startDate must be in YYYY-MM-DD format: %w", err)
+			}
+			end = from.AddDate(0, 0, 6).Format("2006-01-02")
+		}
+
+		filter := &nhl.ScheduleFilter{}
+		if teamsArg, ok := request.GetArguments()["teams"]; ok && teamsArg != nil {
+			teams, ok := teamsArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, teams must be a comma separated string of team abbreviations")
+			}
+			filter.Teams = splitList(teams)
+		}
+		if homeAwayArg, ok := request.GetArguments()["homeAway"]; ok && homeAwayArg != nil {
+			value, ok := homeAwayArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, homeAway must be one of: home, away")
+			}
+			homeAway, err := nhl.ParseHomeAway(value)
+			if err != nil {
+				return nil, err
+			}
+			filter.HomeAway = homeAway
+		}
+		if gameTypeArg, ok := request.GetArguments()["gameType"]; ok && gameTypeArg != nil {
+			switch gameTypeArg {
+			case "regular":
+				filter.GameTypes = []nhl.GameType{nhl.GameTypeRegularSeason}
+			case "playoffs":
+				filter.GameTypes = []nhl.GameType{nhl.GameTypePlayoffs}
+			default:
+				return nil, fmt.Errorf("if provided, gameType must be one of: regular, playoffs")
+			}
+		}
+		if statesArg, ok := request.GetArguments()["gameStates"]; ok && statesArg != nil {
+			states, ok := statesArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, gameStates must be a comma separated string such as FUT,LIVE")
+			}
+			for _, s := range splitList(states) {
+				state, err := nhl.ParseGameState(s)
+				if err != nil {
+					return nil, err
+				}
+				filter.GameStates = append(filter.GameStates, state)
+			}
+		}
+
+		result, err := client.GetLeagueSchedule(ctx, start, end, filter)
+		if err != nil {
+			return apiErrorResult(fmt.Sprintf("getting schedule from %s to %s", start, end), err)
+		}
+
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	PlayerHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
 		nameArg, ok := request.GetArguments()["name"]
 		if !ok || nameArg == nil {
@@ -52,9 +142,34 @@ var (
 			return nil, fmt.Errorf("name must be a string")
 		}
 
-		players, err := client.SearchPlayer(searchName)
+		var opts nhl.PlayerSearchOptions
+		if activeArg, ok := request.GetArguments()["active"]; ok && activeArg != nil {
+			opts.ActiveOnly, ok = activeArg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("if provided, active must be a boolean")
+			}
+		}
+		if positionArg, ok := request.GetArguments()["position"]; ok && positionArg != nil {
+			position, ok := positionArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, position must be a string")
+			}
+			parsed, err := nhl.ParsePosition(position)
+			if err != nil {
+				return nil, err
+			}
+			opts.Position = parsed
+		}
+		if teamArg, ok := request.GetArguments()["team"]; ok && teamArg != nil {
+			opts.Team, ok = teamArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, team must be a string")
+			}
+		}
+
+		players, err := client.SearchPlayers(ctx, searchName, opts)
 		if err != nil {
-			return nil, fmt.Errorf("error searching for player %s: %v", searchName, err)
+			return apiErrorResult(fmt.Sprintf("searching for player %s", searchName), err)
 		}
 
 		if len(players) == 0 {
@@ -63,9 +178,9 @@ var (
 
 		// Get stats for the first player found
 		player := players[0]
-		result, err := client.GetFilteredPlayerStats(player.PlayerID, nil)
+		result, err := client.GetFilteredPlayerStats(ctx, player.PlayerID, nil)
 		if err != nil {
-			return nil, fmt.Errorf("error getting stats for player %d: %v", player.PlayerID, err)
+			return apiErrorResult(fmt.Sprintf("getting stats for player %d", player.PlayerID), err)
 		}
 
 		response := struct {
@@ -78,13 +193,13 @@ var (
 
 		jsonData, err := json.MarshalIndent(response, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	StandingsHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
 		var date string
 		if dateArg, ok := request.GetArguments()["date"]; ok && dateArg != nil {
@@ -106,12 +221,12 @@ var (
 		var standings *nhl.StandingsResponse
 		var err error
 		if date != "" {
-			standings, err = client.GetStandingsByDate(date)
+			standings, err = client.GetStandingsByDate(ctx, date)
 		} else {
-			standings, err = client.GetStandings()
+			standings, err = client.GetStandings(ctx)
 		}
 		if err != nil {
-			return nil, err
+			return apiErrorResult("getting standings", err)
 		}
 
 		// Filter by type if specified
@@ -138,13 +253,13 @@ var (
 
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	RosterHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
 		teamArg, ok := request.GetArguments()["team"]
 		if !ok || teamArg == nil {
@@ -156,20 +271,51 @@ var (
 			return nil, fmt.Errorf("team must be a string")
 		}
 
-		result, err := client.GetTeamRoster(team)
+		if listArg, ok := request.GetArguments()["listSeasons"]; ok && listArg != nil {
+			list, ok := listArg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("if provided, listSeasons must be a boolean")
+			}
+			if list {
+				seasons, err := client.GetRosterSeasons(ctx, team)
+				if err != nil {
+					return apiErrorResult(fmt.Sprintf("getting roster seasons for %s", team), err)
+				}
+
+				jsonData, err := json.MarshalIndent(seasons, "", "  ")
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling response: %w", err)
+				}
+				return mcp.NewToolResultText(string(jsonData)), nil
+			}
+		}
+
+		var seasonID int
+		if seasonIDArg, ok := request.GetArguments()["seasonID"]; ok && seasonIDArg != nil {
+			switch v := seasonIDArg.(type) {
+			case float64:
+				seasonID = int(v)
+			case int:
+				seasonID = v
+			default:
+				return nil, fmt.Errorf("if provided, seasonID must be a number")
+			}
+		}
+
+		result, err := client.GetTeamRosterForSeason(ctx, team, seasonID)
 		if err != nil {
-			return nil, fmt.Errorf("error getting team roster for %s: %v", team, err)
+			return apiErrorResult(fmt.Sprintf("getting team roster for %s", team), err)
 		}
 
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	ScheduleHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
 		teamArg, ok := request.GetArguments()["team"]
 		if !ok || teamArg == nil {
@@ -193,67 +339,320 @@ var (
 			seasonID = formatters.GetCurrentSeasonID()
 		}
 
-		teamInfo, err := client.GetTeamByIdentifier(team)
+		teamInfo, err := client.GetTeamByIdentifier(ctx, team)
 		if err != nil {
-			return nil, fmt.Errorf("error getting team: %v", err)
+			return apiErrorResult("getting team", err)
 		}
 
-		result, err := client.GetTeamSchedule(teamInfo, seasonID)
+		result, err := client.GetTeamSchedule(ctx, teamInfo, seasonID)
 		if err != nil {
-			return nil, fmt.Errorf("error getting schedule for season %d: %v", seasonID, err)
+			return apiErrorResult(fmt.Sprintf("getting schedule for season %d", seasonID), err)
 		}
 
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	LeadersHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		// TODO: broken
-		client := nhl.NewClient()
+		client := getClient()
+
+		var query nhl.LeadersQuery
+		if seasonIDArg, ok := request.GetArguments()["seasonID"]; ok && seasonIDArg != nil {
+			switch v := seasonIDArg.(type) {
+			case float64:
+				query.SeasonID = int(v)
+			case int:
+				query.SeasonID = v
+			default:
+				return nil, fmt.Errorf("if provided, seasonID must be a number")
+			}
+		}
+
+		if gameTypeArg, ok := request.GetArguments()["gameType"]; ok && gameTypeArg != nil {
+			switch gameTypeArg {
+			case "regular":
+			case "playoffs":
+				query.GameType = nhl.GameTypePlayoffs
+			default:
+				return nil, fmt.Errorf("if provided, gameType must be one of: regular, playoffs")
+			}
+		}
+
+		if limitArg, ok := request.GetArguments()["limit"]; ok && limitArg != nil {
+			switch v := limitArg.(type) {
+			case float64:
+				query.Limit = int(v)
+			case int:
+				query.Limit = v
+			default:
+				return nil, fmt.Errorf("if provided, limit must be a number")
+			}
+		}
+
+		if categoriesArg, ok := request.GetArguments()["categories"]; ok && categoriesArg != nil {
+			list, ok := categoriesArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, categories must be a comma separated string")
+			}
+			categories, err := nhl.ParseLeaderCategories(list)
+			if err != nil {
+				return nil, err
+			}
+			query.Categories = categories
+		}
+
+		if typeArg, ok := request.GetArguments()["type"]; ok && typeArg != nil && len(query.Categories) == 0 {
+			switch typeArg {
+			case "all":
+			case "skater":
+				query.Categories = nhl.SkaterLeaderCategories
+			case "goalie":
+				query.Categories = nhl.GoalieLeaderCategories
+			default:
+				return nil, fmt.Errorf("if provided, type must be one of: skater, goalie, all")
+			}
+		}
+
+		result, err := client.GetLeaders(ctx, query)
+		if err != nil {
+			return apiErrorResult("getting leaders", err)
+		}
+
+		jsonData, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling response: %w", err)
+		}
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	ClubStatsHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client := getClient()
+
+		teamArg, ok := request.GetArguments()["team"]
+		if !ok || teamArg == nil {
+			return nil, fmt.Errorf("team parameter is required")
+		}
+
+		team, ok := teamArg.(string)
+		if !ok {
+			return nil, fmt.Errorf("team must be a string")
+		}
+
+		if listArg, ok := request.GetArguments()["listSeasons"]; ok && listArg != nil {
+			list, ok := listArg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("if provided, listSeasons must be a boolean")
+			}
+			if list {
+				seasons, err := client.GetClubStatsSeasons(ctx, team)
+				if err != nil {
+					return apiErrorResult(fmt.Sprintf("getting club stats seasons for %s", team), err)
+				}
+
+				jsonData, err := json.MarshalIndent(seasons, "", "  ")
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling response: %w", err)
+				}
+				return mcp.NewToolResultText(string(jsonData)), nil
+			}
+		}
 
 		var seasonID int
 		if seasonIDArg, ok := request.GetArguments()["seasonID"]; ok && seasonIDArg != nil {
-			seasonID, ok = seasonIDArg.(int)
+			switch v := seasonIDArg.(type) {
+			case float64:
+				seasonID = int(v)
+			case int:
+				seasonID = v
+			default:
+				return nil, fmt.Errorf("if provided, seasonID must be a number")
+			}
+		}
+
+		gameType := nhl.GameTypeRegularSeason
+		if gameTypeArg, ok := request.GetArguments()["gameType"]; ok && gameTypeArg != nil {
+			switch gameTypeArg {
+			case "regular":
+			case "playoffs":
+				gameType = nhl.GameTypePlayoffs
+			default:
+				return nil, fmt.Errorf("if provided, gameType must be one of: regular, playoffs")
+			}
+		}
+
+		sortKey := "points"
+		if sortArg, ok := request.GetArguments()["sort"]; ok && sortArg != nil {
+			sortKey, ok = sortArg.(string)
 			if !ok {
-				return nil, fmt.Errorf("if provided, seasonID must be an integer")
+				return nil, fmt.Errorf("if provided, sort must be a string")
 			}
 		}
 
-		if seasonID == 0 {
-			seasonID = formatters.GetCurrentSeasonID()
+		result, err := client.GetClubStats(ctx, team, seasonID, gameType)
+		if err != nil {
+			return apiErrorResult(fmt.Sprintf("getting club stats for %s", team), err)
+		}
+		if err := result.Sort(sortKey); err != nil {
+			return nil, err
 		}
 
-		result, err := client.GetStatsLeaders(seasonID)
+		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error getting leaders: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
+		}
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	PlayoffsHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client := getClient()
+
+		year := formatters.GetCurrentSeasonID() % 10000
+		if yearArg, ok := request.GetArguments()["year"]; ok && yearArg != nil {
+			switch v := yearArg.(type) {
+			case float64:
+				year = int(v)
+			case int:
+				year = v
+			default:
+				return nil, fmt.Errorf("if provided, year must be a number")
+			}
+		}
+
+		var result interface{}
+		if seriesArg, ok := request.GetArguments()["series"]; ok && seriesArg != nil && seriesArg != "" {
+			letter, ok := seriesArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, series must be a letter from A to O")
+			}
+
+			seasonID := (year-1)*10000 + year
+			series, err := client.GetPlayoffSeries(ctx, seasonID, letter)
+			if err != nil {
+				return apiErrorResult(fmt.Sprintf("getting %d playoff series %s", year, letter), err)
+			}
+			result = map[string]interface{}{
+				"series":  series,
+				"state":   series.State(),
+				"gameIds": series.GameIDs(),
+			}
+		} else {
+			bracket, err := client.GetPlayoffBracket(ctx, year)
+			if err != nil {
+				return apiErrorResult(fmt.Sprintf("getting %d playoff bracket", year), err)
+			}
+			states := make(map[string]nhl.SeriesState, len(bracket.Series))
+			for _, series := range bracket.Series {
+				states[series.SeriesLetter] = series.State()
+			}
+			result = map[string]interface{}{
+				"bracket": bracket,
+				"states":  states,
+			}
 		}
 
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
-	GameHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+	DraftHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client := getClient()
+
+		var year, round int
+		if yearArg, ok := request.GetArguments()["year"]; ok && yearArg != nil {
+			switch v := yearArg.(type) {
+			case float64:
+				year = int(v)
+			case int:
+				year = v
+			default:
+				return nil, fmt.Errorf("if provided, year must be a number")
+			}
+		}
+		if roundArg, ok := request.GetArguments()["round"]; ok && roundArg != nil {
+			switch v := roundArg.(type) {
+			case float64:
+				round = int(v)
+			case int:
+				round = v
+			default:
+				return nil, fmt.Errorf("if provided, round must be a number")
+			}
+		}
+
+		var result interface{}
+		if playerArg, ok := request.GetArguments()["player"]; ok && playerArg != nil && playerArg != "" {
+			searchName, ok := playerArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, player must be a string")
+			}
+
+			players, err := client.SearchPlayer(ctx, searchName)
+			if err != nil {
+				return apiErrorResult(fmt.Sprintf("searching for player %s", searchName), err)
+			}
+			if len(players) == 0 {
+				return nil, fmt.Errorf("could not find any players matching '%s'", searchName)
+			}
+
+			player := players[0]
+			details, err := client.GetPlayerDraftDetails(ctx, player.PlayerID)
+			if err != nil {
+				return apiErrorResult(fmt.Sprintf("getting draft details for player %d", player.PlayerID), err)
+			}
+			result = map[string]interface{}{
+				"player":       player,
+				"drafted":      details != nil,
+				"draftDetails": details,
+			}
+		} else if rankingsArg, ok := request.GetArguments()["rankings"]; ok && rankingsArg != nil && rankingsArg != "" {
+			key, ok := rankingsArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, rankings must be a string")
+			}
+			category, err := nhl.ParseDraftRankingCategory(key)
+			if err != nil {
+				return nil, err
+			}
 
-		gameIDArg, ok := request.GetArguments()["gameId"]
-		if !ok || gameIDArg == nil {
-			return nil, fmt.Errorf("gameId parameter is required")
+			rankings, err := client.GetDraftRankings(ctx, year, category)
+			if err != nil {
+				return apiErrorResult("getting draft rankings", err)
+			}
+			result = rankings
+		} else {
+			draft, err := client.GetDraftPicks(ctx, year, round)
+			if err != nil {
+				return apiErrorResult("getting draft picks", err)
+			}
+			if teamArg, ok := request.GetArguments()["team"]; ok && teamArg != nil && teamArg != "" {
+				team, ok := teamArg.(string)
+				if !ok {
+					return nil, fmt.Errorf("if provided, team must be a string")
+				}
+				draft.Picks = draft.ByTeam(team)
+			}
+			result = draft
 		}
 
-		var gameID int
-		switch v := gameIDArg.(type) {
-		case float64:
-			gameID = int(v)
-		case int:
-			gameID = v
-		default:
-			return nil, fmt.Errorf("gameId must be a number")
+		jsonData, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling response: %w", err)
+		}
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	GameHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client := getClient()
+
+		gameID, err := gameIDArg(ctx, client, request.GetArguments())
+		if err != nil {
+			return nil, err
 		}
 
 		include := "details"
@@ -267,112 +666,271 @@ var (
 
 		switch include {
 		case "all":
-			details, err := client.GetGameDetails(gameID)
+			details, err := client.GetGameDetails(ctx, gameID)
 			if err == nil {
 				response["details"] = details
 			}
-			boxscore, err := client.GetGameBoxscore(gameID)
+			boxscore, err := client.GetGameBoxscore(ctx, gameID)
 			if err == nil {
 				response["boxscore"] = boxscore
 			}
-			plays, err := client.GetGamePlayByPlay(gameID)
+			plays, err := client.GetGamePlayByPlay(ctx, gameID)
 			if err == nil {
 				response["plays"] = plays
 			}
-			story, err := client.GetGameStory(gameID)
+			story, err := client.GetGameStory(ctx, gameID)
 			if err == nil {
 				response["story"] = story
 			}
+			rail, err := client.GetGameRightRail(ctx, gameID)
+			if err == nil {
+				response["rail"] = rail
+			}
 		case "boxscore":
-			boxscore, err := client.GetGameBoxscore(gameID)
+			boxscore, err := client.GetGameBoxscore(ctx, gameID)
 			if err != nil {
-				return nil, fmt.Errorf("error getting boxscore: %v", err)
+				return apiErrorResult("getting boxscore", err)
 			}
 			response["boxscore"] = boxscore
 		case "plays":
-			plays, err := client.GetGamePlayByPlay(gameID)
+			plays, err := client.GetGamePlayByPlay(ctx, gameID)
 			if err != nil {
-				return nil, fmt.Errorf("error getting play-by-play: %v", err)
+				return apiErrorResult("getting play-by-play", err)
 			}
 			response["plays"] = plays
 		case "story":
-			story, err := client.GetGameStory(gameID)
+			story, err := client.GetGameStory(ctx, gameID)
 			if err != nil {
-				return nil, fmt.Errorf("error getting game story: %v", err)
+				return apiErrorResult("getting game story", err)
 			}
 			response["story"] = story
+		case "rail":
+			rail, err := client.GetGameRightRail(ctx, gameID)
+			if err != nil {
+				return apiErrorResult("getting game right rail", err)
+			}
+			response["rail"] = rail
 		default: // "details"
-			details, err := client.GetGameDetails(gameID)
+			details, err := client.GetGameDetails(ctx, gameID)
 			if err != nil {
-				return nil, fmt.Errorf("error getting game details: %v", err)
+				return apiErrorResult("getting game details", err)
 			}
 			response["details"] = details
 		}
 
 		jsonData, err := json.MarshalIndent(response, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	LiveHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
-		result, err := client.GetLiveGameUpdates()
+		result, err := client.GetLiveGameUpdates(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("error getting live updates: %v", err)
+			return apiErrorResult("getting live updates", err)
 		}
 
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	TeamsHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
-		result, err := client.GetTeams()
+		result, err := client.GetTeams(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("error getting teams: %v", err)
+			return apiErrorResult("getting teams", err)
 		}
 
 		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 
 	HighlightsHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client := nhl.NewClient()
+		client := getClient()
 
-		gameIDArg, ok := request.GetArguments()["gameId"]
-		if !ok || gameIDArg == nil {
-			return nil, fmt.Errorf("gameId parameter is required")
+		gameID, err := gameIDArg(ctx, client, request.GetArguments())
+		if err != nil {
+			return nil, err
 		}
 
-		var gameID int
-		switch v := gameIDArg.(type) {
-		case float64:
-			gameID = int(v)
-		case int:
-			gameID = v
-		default:
-			return nil, fmt.Errorf("gameId must be a number")
+		result, err := client.GetGameHighlights(ctx, gameID)
+		if err != nil {
+			return apiErrorResult("getting highlights", err)
 		}
 
-		result, err := client.GetGameHighlights(gameID)
+		jsonData, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("error getting highlights: %v", err)
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
 
-		jsonData, err := json.MarshalIndent(result, "", "  ")
+	GameLogHandler server.ToolHandlerFunc = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client := getClient()
+
+		nameArg, ok := request.GetArguments()["name"]
+		if !ok || nameArg == nil {
+			return nil, fmt.Errorf("name parameter is required")
+		}
+
+		searchName, ok := nameArg.(string)
+		if !ok {
+			return nil, fmt.Errorf("name must be a string")
+		}
+
+		var seasonID int
+		if seasonIDArg, ok := request.GetArguments()["seasonID"]; ok && seasonIDArg != nil {
+			switch v := seasonIDArg.(type) {
+			case float64:
+				seasonID = int(v)
+			case int:
+				seasonID = v
+			default:
+				return nil, fmt.Errorf("if provided, seasonID must be a number")
+			}
+		}
+
+		gameType := nhl.GameTypeRegularSeason
+		if gameTypeArg, ok := request.GetArguments()["gameType"]; ok && gameTypeArg != nil {
+			switch gameTypeArg {
+			case "regular":
+			case "playoffs":
+				gameType = nhl.GameTypePlayoffs
+			default:
+				return nil, fmt.Errorf("if provided, gameType must be one of: regular, playoffs")
+			}
+		}
+
+		var from, to string
+		if fromArg, ok := request.GetArguments()["from"]; ok && fromArg != nil {
+			from, ok = fromArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, from must be a string in YYYY-MM-DD format")
+			}
+		}
+		if toArg, ok := request.GetArguments()["to"]; ok && toArg != nil {
+			to, ok = toArg.(string)
+			if !ok {
+				return nil, fmt.Errorf("if provided, to must be a string in YYYY-MM-DD format")
+			}
+		}
+
+		players, err := client.SearchPlayer(ctx, searchName)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling response: %v", err)
+			return apiErrorResult(fmt.Sprintf("searching for player %s", searchName), err)
+		}
+
+		if len(players) == 0 {
+			return nil, fmt.Errorf("could not find any players matching '%s'", searchName)
+		}
+
+		player := players[0]
+		gameLog, err := client.GetPlayerGameLog(ctx, player.PlayerID, seasonID, gameType)
+		if err != nil {
+			return apiErrorResult(fmt.Sprintf("getting game log for player %d", player.PlayerID), err)
+		}
+
+		gameLog, err = gameLog.Between(from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		var totals interface{} = gameLog.SkaterTotals()
+		if gameLog.Goalie {
+			totals = gameLog.GoalieTotals()
+		}
+
+		response := struct {
+			Player  nhl.PlayerSearchResult `json:"player"`
+			GameLog *nhl.PlayerGameLog     `json:"gameLog"`
+			Totals  interface{}            `json:"totals"`
+		}{
+			Player:  player,
+			GameLog: gameLog,
+			Totals:  totals,
+		}
+
+		jsonData, err := json.MarshalIndent(response, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling response: %w", err)
 		}
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 )
+
+// gameIDArg reads the required gameId argument: a number, or a string in
+// any form ResolveGameID accepts, such as 2023-02-0750 or NYR@CHI 2024-02-09
+func gameIDArg(ctx context.Context, client *nhl.Client, args map[string]any) (nhl.GameID, error) {
+	arg, ok := args["gameId"]
+	if !ok || arg == nil {
+		return 0, fmt.Errorf("gameId parameter is required")
+	}
+
+	var gameID nhl.GameID
+	switch v := arg.(type) {
+	case float64:
+		gameID = nhl.GameID(v)
+	case int:
+		gameID = nhl.GameID(v)
+	case string:
+		return client.ResolveGameID(ctx, v)
+	default:
+		return 0, fmt.Errorf("gameId must be a number or a string such as NYR@CHI 2024-02-09")
+	}
+	if err := gameID.Validate(); err != nil {
+		return 0, err
+	}
+	return gameID, nil
+}
+
+// broadcastFilterArgs builds a broadcast filter from the country, network
+// and market arguments
+func broadcastFilterArgs(args map[string]any) (*nhl.BroadcastFilter, error) {
+	filter := &nhl.BroadcastFilter{}
+	for _, name := range []string{"country", "network", "market"} {
+		arg, ok := args[name]
+		if !ok || arg == nil {
+			continue
+		}
+		value, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("if provided, %s must be a comma separated string", name)
+		}
+		switch name {
+		case "country":
+			filter.Countries = splitList(value)
+		case "network":
+			filter.Networks = splitList(value)
+		case "market":
+			for _, m := range splitList(value) {
+				market, err := nhl.ParseMarket(m)
+				if err != nil {
+					return nil, err
+				}
+				filter.Markets = append(filter.Markets, market)
+			}
+		}
+	}
+	return filter, nil
+}
+
+// splitList splits a comma separated argument, dropping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"go-nhl/client/nhltest"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// callTool runs handler with args and returns the text of the result
+func callTool(t *testing.T, handler server.ToolHandlerFunc, args map[string]any) (string, bool) {
+	t.Helper()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = args
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+
+	var text strings.Builder
+	for _, content := range result.Content {
+		if c, ok := content.(mcp.TextContent); ok {
+			text.WriteString(c.Text)
+		}
+	}
+	return text.String(), result.IsError
+}
+
+func TestHandlers(t *testing.T) {
+	fake := nhltest.NewServer()
+	defer fake.Close()
+	SetClient(fake.Client())
+
+	tests := []struct {
+		name    string
+		handler server.ToolHandlerFunc
+		args    map[string]any
+		want    []string
+	}{
+		{
+			name:    "Slate",
+			handler: SlateHandler,
+			args:    map[string]any{"date": nhltest.FixtureDate},
+			want:    []string{`"id": 2024020750`, `"abbrev": "NYR"`},
+		},
+		{
+			name:    "Slate by network",
+			handler: SlateHandler,
+			args:    map[string]any{"date": nhltest.FixtureDate, "network": "sn", "market": "national"},
+			want:    []string{`"id": 2024020750`, `"network": "SN"`},
+		},
+		{
+			name:    "Slate without matching broadcasts",
+			handler: SlateHandler,
+			args:    map[string]any{"date": nhltest.FixtureDate, "country": "US", "market": "national"},
+			want:    []string{`"games": []`},
+		},
+		{
+			name:    "League schedule",
+			handler: LeagueScheduleHandler,
+			args:    map[string]any{"startDate": "2024-02-05", "teams": "NYR", "homeAway": "away"},
+			want:    []string{`"endDate": "2024-02-11"`, `"date": "2024-02-09"`, `"id": 2024020750`},
+		},
+		{
+			name:    "Player",
+			handler: PlayerHandler,
+			args:    map[string]any{"name": "Heiskanen"},
+			want:    []string{`"playerId": 8480036`, `"season": 20232024`},
+		},
+		{
+			name:    "Player with filters",
+			handler: PlayerHandler,
+			args:    map[string]any{"name": "Shesterkin", "position": "G", "team": "NYR", "active": true},
+			want:    []string{`"playerId": 8478048`, `"birthYear": 1995`},
+		},
+		{
+			name:    "Game log",
+			handler: GameLogHandler,
+			args:    map[string]any{"name": "Shesterkin", "seasonID": float64(nhltest.FixtureSeason), "to": "2024-02-05"},
+			want:    []string{`"goalie": true`, `"gameId": 2024020705`, `"gamesPlayed": 3`},
+		},
+		{
+			name:    "Division standings",
+			handler: StandingsHandler,
+			args:    map[string]any{"type": "division"},
+			want:    []string{`"Metropolitan"`, `"Pacific"`},
+		},
+		{
+			name:    "Roster",
+			handler: RosterHandler,
+			args:    map[string]any{"team": "CHI"},
+			want:    []string{"Bedard"},
+		},
+		{
+			name:    "Schedule",
+			handler: ScheduleHandler,
+			args:    map[string]any{"team": "NYR", "seasonID": nhltest.FixtureSeason},
+			want:    []string{`"id": 2024020750`},
+		},
+		{
+			name:    "Leaders",
+			handler: LeadersHandler,
+			args:    map[string]any{},
+			want:    []string{"Panarin", `"savePctg"`},
+		},
+		{
+			name:    "Goalie leaders",
+			handler: LeadersHandler,
+			args:    map[string]any{"type": "goalie", "limit": float64(1), "seasonID": float64(nhltest.FixtureSeason)},
+			want:    []string{`"wins"`, "Hellebuyck"},
+		},
+		{
+			name:    "Club stats",
+			handler: ClubStatsHandler,
+			args:    map[string]any{"team": "NYR", "seasonID": float64(nhltest.FixtureSeason), "sort": "wins"},
+			want:    []string{`"season": "20232024"`, "Panarin", "Shesterkin"},
+		},
+		{
+			name:    "Club stats seasons",
+			handler: ClubStatsHandler,
+			args:    map[string]any{"team": "NYR", "listSeasons": true},
+			want:    []string{`"season": 20232024`},
+		},
+		{
+			name:    "Playoff bracket",
+			handler: PlayoffsHandler,
+			args:    map[string]any{"year": float64(2024)},
+			want:    []string{`"seriesLetter": "O"`, `"O": "complete"`},
+		},
+		{
+			name:    "Playoff series",
+			handler: PlayoffsHandler,
+			args:    map[string]any{"year": float64(2024), "series": "A"},
+			want:    []string{`"state": "complete"`, "2023030115"},
+		},
+		{
+			name:    "Draft picks",
+			handler: DraftHandler,
+			args:    map[string]any{"year": float64(2015), "round": float64(1)},
+			want:    []string{`"overallPick": 1`, "McDavid"},
+		},
+		{
+			name:    "Draft rankings",
+			handler: DraftHandler,
+			args:    map[string]any{"year": float64(2024), "rankings": "north-american-skater"},
+			want:    []string{"Celebrini"},
+		},
+		{
+			name:    "Player draft",
+			handler: DraftHandler,
+			args:    map[string]any{"player": "Shesterkin"},
+			want:    []string{`"drafted": true`, `"overallPick": 118`},
+		},
+		{
+			name:    "Roster for a season",
+			handler: RosterHandler,
+			args:    map[string]any{"team": "BOS", "seasonID": float64(20102011)},
+			want:    []string{"Chara", "Rask"},
+		},
+		{
+			name:    "Roster seasons",
+			handler: RosterHandler,
+			args:    map[string]any{"team": "BOS", "listSeasons": true},
+			want:    []string{"20102011"},
+		},
+		{
+			name:    "Game boxscore",
+			handler: GameHandler,
+			args:    map[string]any{"gameId": float64(nhltest.FixtureGameID), "include": "boxscore"},
+			want:    []string{`"boxscore"`, "Shesterkin"},
+		},
+		{
+			name:    "Game right rail",
+			handler: GameHandler,
+			args:    map[string]any{"gameId": float64(nhltest.FixtureGameID), "include": "rail"},
+			want:    []string{`"awayTeamWins": 1`, `"category": "faceoffWinningPctg"`, "Wes McCauley"},
+		},
+		{
+			name:    "Live",
+			handler: LiveHandler,
+			args:    map[string]any{},
+			want:    []string{`"focusedDate": "2024-02-09"`},
+		},
+		{
+			name:    "Game by teams and date",
+			handler: GameHandler,
+			args:    map[string]any{"gameId": "NYR@CHI " + nhltest.FixtureDate, "include": "boxscore"},
+			want:    []string{`"id": 2024020750`, "Shesterkin"},
+		},
+		{
+			name:    "Game by dashed ID",
+			handler: GameHandler,
+			args:    map[string]any{"gameId": "2024-02-0750"},
+			want:    []string{"United Center"},
+		},
+		{
+			name:    "Highlights",
+			handler: HighlightsHandler,
+			args:    map[string]any{"gameId": float64(nhltest.FixtureGameID)},
+			want:    []string{"nhl.com/video/nyr-at-chi-recap-2024020750"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, isError := callTool(t, tt.handler, tt.args)
+			if isError {
+				t.Fatalf("tool error: %s", text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("result missing %q", want)
+				}
+			}
+		})
+	}
+}
+
+func TestHandlersAPIErrors(t *testing.T) {
+	fake := nhltest.NewServer()
+	defer fake.Close()
+	SetClient(fake.Client())
+
+	text, isError := callTool(t, GameHandler, map[string]any{"gameId": float64(2024020001)})
+	if !isError || !strings.Contains(text, "Check the game ID") {
+		t.Errorf("unknown game = %q, %v, want a friendly not found error", text, isError)
+	}
+
+	fake.Fail("standings", http.StatusServiceUnavailable, 0)
+	text, isError = callTool(t, StandingsHandler, map[string]any{})
+	if !isError || !strings.Contains(text, "unavailable right now") {
+		t.Errorf("standings outage = %q, %v, want a friendly unavailable error", text, isError)
+	}
+}
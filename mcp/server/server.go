@@ -14,19 +14,58 @@ func Start() error {
 	)
 
 	slateTool := mcp.NewTool("nhl-slate",
-		mcp.WithDescription("Get slate of games for a given date"),
+		mcp.WithDescription("Get slate of games for a given date, optionally only the games on matching TV broadcasts"),
 		mcp.WithString("date",
 			mcp.Required(),
 			mcp.Description("Date (YYYY-MM-DD format)"),
 		),
+		mcp.WithString("country",
+			mcp.Description("Comma separated broadcast country codes, such as US or CA"),
+		),
+		mcp.WithString("network",
+			mcp.Description("Comma separated networks, such as ESPN,SN"),
+		),
+		mcp.WithString("market",
+			mcp.Description("Comma separated broadcast markets: national, home or away"),
+		),
 	)
 
 	playerTool := mcp.NewTool("nhl-player",
-		mcp.WithDescription("Get player info and stats"),
+		mcp.WithDescription("Search current and retired players by name and get the best match's stats"),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Player name, accents and small typos are ignored"),
+		),
+		mcp.WithBoolean("active",
+			mcp.Description("Only find players currently on an NHL roster"),
+		),
+		mcp.WithString("position",
+			mcp.Description("Position code: C, L, R, D, G, or F for any forward"),
+		),
+		mcp.WithString("team",
+			mcp.Description("Team abbreviation, matching the player's current or last team"),
+		),
+	)
+
+	gameLogTool := mcp.NewTool("nhl-player-gamelog",
+		mcp.WithDescription("Get a player's game-by-game stats for a season, with totals"),
 		mcp.WithString("name",
 			mcp.Required(),
 			mcp.Description("Player name"),
 		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20242025, default: current season)"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Game type: regular or playoffs (default: regular)"),
+			mcp.DefaultString("regular"),
+		),
+		mcp.WithString("from",
+			mcp.Description("First date to include (YYYY-MM-DD format)"),
+		),
+		mcp.WithString("to",
+			mcp.Description("Last date to include (YYYY-MM-DD format)"),
+		),
 	)
 
 	standingsTool := mcp.NewTool("nhl-standings",
@@ -41,11 +80,17 @@ func Start() error {
 	)
 
 	rosterTool := mcp.NewTool("nhl-roster",
-		mcp.WithDescription("Get team roster"),
+		mcp.WithDescription("Get a team roster, current or for a past season"),
 		mcp.WithString("team",
 			mcp.Required(),
 			mcp.Description("Team abbreviation"),
 		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20102011, default: current roster)"),
+		),
+		mcp.WithBoolean("listSeasons",
+			mcp.Description("List the seasons the team has rosters for instead"),
+		),
 	)
 
 	scheduleTool := mcp.NewTool("nhl-schedule",
@@ -60,23 +105,107 @@ func Start() error {
 	)
 
 	leadersTool := mcp.NewTool("nhl-leaders",
-		mcp.WithDescription("Get leaders"),
-		// mcp.WithString("type",
-		// 	mcp.Description("Leader type (points, goals, assists, etc.)"),
-		// ),
-		mcp.WithString("seasonID",
-			mcp.Description("Season ID (example: 20242025)"),
+		mcp.WithDescription("Get skater and goalie stats leaders by category"),
+		mcp.WithString("type",
+			mcp.Description("Leader type: skater, goalie or all (default: all)"),
+			mcp.DefaultString("all"),
+		),
+		mcp.WithString("categories",
+			mcp.Description("Comma separated categories, overriding type: points, goals, assists, goalsPp, goalsSh, plusMinus, faceoffLeaders, toi, penaltyMins, wins, savePctg, goalsAgainstAverage, shutouts"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Players per category (-1 for all, default: 5)"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Game type: regular or playoffs (default: regular)"),
+			mcp.DefaultString("regular"),
+		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20242025, default: current season)"),
+		),
+	)
+
+	clubStatsTool := mcp.NewTool("nhl-club-stats",
+		mcp.WithDescription("Get every skater's and goalie's stats for a team in a season"),
+		mcp.WithString("team",
+			mcp.Required(),
+			mcp.Description("Team name or abbreviation"),
+		),
+		mcp.WithNumber("seasonID",
+			mcp.Description("Season ID (example: 20242025, default: current season)"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Game type: regular or playoffs (default: regular)"),
+			mcp.DefaultString("regular"),
+		),
+		mcp.WithString("sort",
+			mcp.Description("Stat to sort by, best first: points, goals, assists, plusMinus, penaltyMinutes, powerPlayGoals, shots, timeOnIce, gamesPlayed, wins, savePercentage, goalsAgainstAverage, shutouts or name (default: points)"),
+			mcp.DefaultString("points"),
+		),
+		mcp.WithBoolean("listSeasons",
+			mcp.Description("List the seasons and game types the team has stats for instead"),
+		),
+	)
+
+	playoffsTool := mcp.NewTool("nhl-playoffs",
+		mcp.WithDescription("Get the playoff bracket, or one playoff series with its games"),
+		mcp.WithNumber("year",
+			mcp.Description("Year the playoffs were played in (example: 2024, default: current season)"),
+		),
+		mcp.WithString("series",
+			mcp.Description("Series letter, A to O, to get that series and its games instead of the bracket"),
+		),
+	)
+
+	draftTool := mcp.NewTool("nhl-draft",
+		mcp.WithDescription("Get the picks of an NHL draft, Central Scouting's prospect rankings, or where a player was drafted"),
+		mcp.WithNumber("year",
+			mcp.Description("Draft year (example: 2015, default: most recent draft for picks, upcoming draft for rankings)"),
+		),
+		mcp.WithNumber("round",
+			mcp.Description("Draft round (default: all rounds)"),
+		),
+		mcp.WithString("team",
+			mcp.Description("Only return picks made by this team abbreviation"),
+		),
+		mcp.WithString("player",
+			mcp.Description("Player name, to get where they were drafted instead"),
+		),
+		mcp.WithString("rankings",
+			mcp.Description("Prospect list to get instead: north-american-skater, international-skater, north-american-goalie or international-goalie"),
+		),
+	)
+
+	leagueScheduleTool := mcp.NewTool("nhl-league-schedule",
+		mcp.WithDescription("Get every game across the league over a range of dates, grouped by date, such as all games this week"),
+		mcp.WithString("startDate",
+			mcp.Description("First date (YYYY-MM-DD format, default: Monday of this week)"),
+		),
+		mcp.WithString("endDate",
+			mcp.Description("Last date (YYYY-MM-DD format, default: six days after startDate)"),
+		),
+		mcp.WithString("teams",
+			mcp.Description("Comma separated team abbreviations to only return games of (example: NYR,BOS)"),
+		),
+		mcp.WithString("homeAway",
+			mcp.Description("Only return games where teams are at home or away: home or away"),
+		),
+		mcp.WithString("gameType",
+			mcp.Description("Only return regular season or playoff games: regular or playoffs"),
+		),
+		mcp.WithString("gameStates",
+			mcp.Description("Comma separated game states to only return (example: FUT,LIVE,OFF)"),
 		),
 	)
 
 	gameTool := mcp.NewTool("nhl-game",
-		mcp.WithDescription("Get detailed game information including boxscore, play-by-play, and game story"),
-		mcp.WithNumber("gameId",
+		mcp.WithDescription("Get detailed game information including boxscore, play-by-play, game story and the season series"),
+		mcp.WithAny("gameId",
 			mcp.Required(),
-			mcp.Description("Game ID"),
+			mcp.Description("Game ID as a number such as 2023020750, or a string such as 2023-02-0750, NYR@CHI 2024-02-09 or NYR 2024-02-09"),
 		),
 		mcp.WithString("include",
-			mcp.Description("What to include: details, boxscore, plays, story, or all (default: details)"),
+			mcp.Description("What to include: details, boxscore, plays, story, rail (season series, team stats, linescore, officials and scratches), or all (default: details)"),
 			mcp.DefaultString("details"),
 		),
 	)
@@ -91,10 +220,15 @@ func Start() error {
 
 	s.AddTool(slateTool, SlateHandler)
 	s.AddTool(playerTool, PlayerHandler)
+	s.AddTool(gameLogTool, GameLogHandler)
 	s.AddTool(standingsTool, StandingsHandler)
 	s.AddTool(rosterTool, RosterHandler)
 	s.AddTool(scheduleTool, ScheduleHandler)
 	s.AddTool(leadersTool, LeadersHandler)
+	s.AddTool(clubStatsTool, ClubStatsHandler)
+	s.AddTool(playoffsTool, PlayoffsHandler)
+	s.AddTool(draftTool, DraftHandler)
+	s.AddTool(leagueScheduleTool, LeagueScheduleHandler)
 	s.AddTool(gameTool, GameHandler)
 	s.AddTool(liveTool, LiveHandler)
 	s.AddTool(teamsTool, TeamsHandler)